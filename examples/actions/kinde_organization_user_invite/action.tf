@@ -0,0 +1,20 @@
+# Add the first administrator to a new organization
+action "kinde_organization_user_invite" "owner" {
+  config {
+    organization_code = kinde_organization.acme.code
+    email             = "owner@acme.example.com"
+    first_name        = "Olivia"
+    roles             = [kinde_role.admin.id]
+  }
+}
+
+resource "kinde_organization" "acme" {
+  name = "Acme Corp"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.kinde_organization_user_invite.owner]
+    }
+  }
+}
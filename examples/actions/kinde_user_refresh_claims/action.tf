@@ -0,0 +1,19 @@
+# Refresh the claims of a user whenever their roles change
+action "kinde_user_refresh_claims" "alice" {
+  config {
+    user_id = kinde_user.alice.id
+  }
+}
+
+resource "kinde_organization_user" "alice" {
+  organization_code = kinde_organization.acme.code
+  user_id           = kinde_user.alice.id
+  roles             = [kinde_role.admin.id]
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.kinde_user_refresh_claims.alice]
+    }
+  }
+}
@@ -0,0 +1,30 @@
+# Suspend a user once they are marked as offboarded
+variable "alice_offboarded" {
+  type    = bool
+  default = false
+}
+
+action "kinde_user_suspend" "alice" {
+  config {
+    user_id = kinde_user.alice.id
+  }
+}
+
+resource "terraform_data" "alice_offboarding" {
+  count = var.alice_offboarded ? 1 : 0
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.kinde_user_suspend.alice]
+    }
+  }
+}
+
+# Reactivate the user, e.g. with `terraform apply -invoke=action.kinde_user_suspend.reactivate_alice`
+action "kinde_user_suspend" "reactivate_alice" {
+  config {
+    user_id   = kinde_user.alice.id
+    suspended = false
+  }
+}
@@ -0,0 +1,14 @@
+# Exchange the credentials of an M2M application for a short-lived token
+ephemeral "kinde_m2m_token" "pipeline" {
+  client_id     = kinde_application.pipeline.client_id
+  client_secret = kinde_application.pipeline.client_secret
+  audience      = "https://example.kinde.com/api"
+}
+
+# Pass the token to another provider without storing it in state
+provider "restapi" {
+  uri = "https://example.kinde.com/api"
+  headers = {
+    Authorization = "Bearer ${ephemeral.kinde_m2m_token.pipeline.access_token}"
+  }
+}
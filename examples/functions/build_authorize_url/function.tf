@@ -0,0 +1,4 @@
+# https://example.kinde.com/oauth2/auth
+output "authorize_url" {
+  value = provider::kinde::build_authorize_url("https://example.kinde.com")
+}
@@ -0,0 +1,4 @@
+# https://example.kinde.com
+output "issuer_url" {
+  value = provider::kinde::build_issuer_url("example.kinde.com")
+}
@@ -0,0 +1,4 @@
+# https://example.kinde.com/.well-known/jwks.json
+output "jwks_url" {
+  value = provider::kinde::build_jwks_url("https://example.kinde.com")
+}
@@ -0,0 +1,3 @@
+output "user_id" {
+  value = provider::kinde::parse_organization_user_id(kinde_organization_user.example.id).user_id
+}
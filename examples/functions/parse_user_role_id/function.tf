@@ -0,0 +1,3 @@
+output "role_id" {
+  value = provider::kinde::parse_user_role_id(kinde_user_role.example.id).role_id
+}
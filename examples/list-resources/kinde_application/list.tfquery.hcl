@@ -0,0 +1,4 @@
+list "kinde_application" "all" {
+  provider         = kinde
+  include_resource = true
+}
@@ -0,0 +1,4 @@
+list "kinde_connection" "all" {
+  provider         = kinde
+  include_resource = true
+}
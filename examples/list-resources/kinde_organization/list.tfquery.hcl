@@ -0,0 +1,4 @@
+list "kinde_organization" "all" {
+  provider         = kinde
+  include_resource = true
+}
@@ -0,0 +1,4 @@
+list "kinde_permission" "all" {
+  provider         = kinde
+  include_resource = true
+}
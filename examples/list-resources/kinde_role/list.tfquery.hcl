@@ -0,0 +1,4 @@
+list "kinde_role" "all" {
+  provider         = kinde
+  include_resource = true
+}
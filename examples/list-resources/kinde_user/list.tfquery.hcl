@@ -0,0 +1,4 @@
+list "kinde_user" "all" {
+  provider         = kinde
+  include_resource = true
+}
@@ -0,0 +1,7 @@
+import {
+  to = kinde_application_connection.example
+  identity = {
+    application_id = "0190b6a1-application"
+    connection_id  = "conn_1234"
+  }
+}
@@ -0,0 +1,2 @@
+# application_id:connection_id
+terraform import kinde_application_connection.example 0190b6a1-application:conn_1234
@@ -0,0 +1,7 @@
+import {
+  to = kinde_organization_connection.example
+  identity = {
+    organization_code = "org_1234"
+    connection_id     = "conn_1234"
+  }
+}
@@ -0,0 +1,2 @@
+# organization_code:connection_id
+terraform import kinde_organization_connection.example org_1234:conn_1234
@@ -0,0 +1,7 @@
+import {
+  to = kinde_organization_logo.example
+  identity = {
+    organization_code = "org_1234"
+    type              = "light"
+  }
+}
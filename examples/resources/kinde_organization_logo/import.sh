@@ -0,0 +1,2 @@
+# organization_code:type
+terraform import kinde_organization_logo.example org_1234:light
@@ -0,0 +1,7 @@
+import {
+  to = kinde_organization_user.example
+  identity = {
+    organization_code = "org_1234"
+    user_id           = "kp_1234"
+  }
+}
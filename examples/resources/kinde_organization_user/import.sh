@@ -0,0 +1,2 @@
+# organization_code:user_id
+terraform import kinde_organization_user.example org_1234:kp_1234
@@ -0,0 +1,6 @@
+import {
+  to = kinde_permission.example
+  identity = {
+    id = "perm_1234"
+  }
+}
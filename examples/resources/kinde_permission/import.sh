@@ -0,0 +1,5 @@
+# Import by ID
+terraform import kinde_permission.example <permission-id>
+
+# Import by key
+terraform import kinde_permission.example key:<permission-key>
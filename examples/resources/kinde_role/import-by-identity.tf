@@ -0,0 +1,6 @@
+import {
+  to = kinde_role.example
+  identity = {
+    id = "role_1234"
+  }
+}
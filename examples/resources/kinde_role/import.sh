@@ -0,0 +1,5 @@
+# Import by ID
+terraform import kinde_role.example <role-id>
+
+# Import by key
+terraform import kinde_role.example key:<role-key>
@@ -0,0 +1,6 @@
+import {
+  to = kinde_user.example
+  identity = {
+    id = "kp_1234"
+  }
+}
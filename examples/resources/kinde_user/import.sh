@@ -0,0 +1,8 @@
+# Import by ID
+terraform import kinde_user.example <user-id>
+
+# Import by email
+terraform import kinde_user.example email:alice@example.com
+
+# Import by username
+terraform import kinde_user.example username:alice
@@ -0,0 +1,7 @@
+import {
+  to = kinde_user_identity.example
+  identity = {
+    user_id     = "kp_1234"
+    identity_id = "identity_1234"
+  }
+}
@@ -0,0 +1,2 @@
+# user_id:identity_id
+terraform import kinde_user_identity.example kp_1234:identity_1234
@@ -0,0 +1,29 @@
+resource "kinde_user" "example" {
+  first_name = "Jane"
+  last_name  = "Doe"
+  identities = [
+    {
+      type  = "email"
+      value = "jane.doe@example.com"
+    }
+  ]
+
+  # Identities added by kinde_user_identity resources are not removed
+  lifecycle {
+    ignore_changes = [identities]
+  }
+}
+
+# Secondary email managed separately from the user
+resource "kinde_user_identity" "work_email" {
+  user_id = kinde_user.example.id
+  type    = "email"
+  value   = "jane.doe@work.example.com"
+}
+
+# Phone number in international format
+resource "kinde_user_identity" "phone" {
+  user_id = kinde_user.example.id
+  type    = "phone"
+  value   = "+15551234567"
+}
@@ -0,0 +1,8 @@
+import {
+  to = kinde_user_role.example
+  identity = {
+    organization_code = "org_1234"
+    user_id           = "kp_1234"
+    role_id           = "0190b6a1-role"
+  }
+}
@@ -0,0 +1,2 @@
+# organization_code:user_id:role_id
+terraform import kinde_user_role.example org_1234:kp_1234:0190b6a1-role
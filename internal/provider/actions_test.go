@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/nxt-fwd/kinde-go"
+)
+
+// invokeAction configures an action with client and invokes it with the
+// given configuration values. Attributes that are not set are null.
+func invokeAction(t *testing.T, a action.Action, client *kinde.Client, values map[string]tftypes.Value) ([]string, action.InvokeResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	var configureResp action.ConfigureResponse
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: newProviderData(client)}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure error: %v", configureResp.Diagnostics)
+	}
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		}
+	}
+
+	var progress []string
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			progress = append(progress, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, attributes),
+		},
+	}, &resp)
+	return progress, resp
+}
+
+func TestUserSuspendAction_Reactivate(t *testing.T) {
+	var body map[string]any
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/user" && r.URL.Query().Get("id") == "kp_alice":
+			raw, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(raw, &body)
+			_, _ = w.Write([]byte(`{"id":"kp_alice","is_suspended":false}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	progress, resp := invokeAction(t, NewUserSuspendAction(), newTestClient(server), map[string]tftypes.Value{
+		"user_id":   tftypes.NewValue(tftypes.String, "kp_alice"),
+		"suspended": tftypes.NewValue(tftypes.Bool, false),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if suspended, ok := body["is_suspended"].(bool); !ok || suspended {
+		t.Errorf("expected is_suspended false, got %v", body["is_suspended"])
+	}
+	if len(progress) != 1 || progress[0] != "Reactivated user kp_alice" {
+		t.Errorf("unexpected progress: %v", progress)
+	}
+}
+
+func TestOrganizationUserInviteAction_CreatesUser(t *testing.T) {
+	var created, added map[string]any
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/users" && r.URL.Query().Get("email") == "alice@example.com":
+			_, _ = w.Write([]byte(`{"users":[]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/user":
+			raw, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(raw, &created)
+			_, _ = w.Write([]byte(`{"id":"kp_alice","created":true}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/user" && r.URL.Query().Get("id") == "kp_alice":
+			_, _ = w.Write([]byte(`{"id":"kp_alice","first_name":"Alice"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/roles":
+			_, _ = w.Write([]byte(`{"roles":[{"id":"role_admin","key":"admin","name":"Admin"}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/organizations/org_acme/users":
+			raw, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(raw, &added)
+			_, _ = w.Write([]byte(`{"code":"OK","users_added":["kp_alice"]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	progress, resp := invokeAction(t, NewOrganizationUserInviteAction(), newTestClient(server), map[string]tftypes.Value{
+		"organization_code": tftypes.NewValue(tftypes.String, "org_acme"),
+		"email":             tftypes.NewValue(tftypes.String, "alice@example.com"),
+		"first_name":        tftypes.NewValue(tftypes.String, "Alice"),
+		"roles": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "role_admin"),
+		}),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	profile, _ := created["profile"].(map[string]any)
+	if profile["given_name"] != "Alice" {
+		t.Errorf("expected user to be created with given name Alice, got %v", created)
+	}
+
+	users, _ := added["users"].([]any)
+	if len(users) != 1 {
+		t.Fatalf("expected 1 added user, got %v", added)
+	}
+	user, _ := users[0].(map[string]any)
+	roles, _ := user["roles"].([]any)
+	if user["id"] != "kp_alice" || len(roles) != 1 || roles[0] != "admin" {
+		t.Errorf("expected kp_alice to be added with role admin, got %v", user)
+	}
+
+	if len(progress) != 2 {
+		t.Errorf("expected 2 progress messages, got %v", progress)
+	}
+}
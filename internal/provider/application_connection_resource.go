@@ -4,19 +4,19 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/nxt-fwd/kinde-go"
 	"github.com/nxt-fwd/kinde-go/api/applications"
 )
 
 var (
 	_ resource.Resource                = &ApplicationConnectionResource{}
 	_ resource.ResourceWithImportState = &ApplicationConnectionResource{}
+	_ resource.ResourceWithIdentity    = &ApplicationConnectionResource{}
 )
 
 func NewApplicationConnectionResource() resource.Resource {
@@ -33,6 +33,20 @@ type applicationConnectionResourceModel struct {
 	ConnectionID  types.String `tfsdk:"connection_id"`
 }
 
+// applicationConnectionResourceIdentityModel identifies an enabled connection by
+// application and connection.
+type applicationConnectionResourceIdentityModel struct {
+	ApplicationID types.String `tfsdk:"application_id"`
+	ConnectionID  types.String `tfsdk:"connection_id"`
+}
+
+func applicationConnectionIdentity(model applicationConnectionResourceModel) applicationConnectionResourceIdentityModel {
+	return applicationConnectionResourceIdentityModel{
+		ApplicationID: model.ApplicationID,
+		ConnectionID:  model.ConnectionID,
+	}
+}
+
 func (r *ApplicationConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application_connection"
 }
@@ -61,16 +75,31 @@ func (r *ApplicationConnectionResource) Schema(_ context.Context, _ resource.Sch
 	}
 }
 
+func (r *ApplicationConnectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"application_id": identityschema.StringAttribute{
+				Description:       "ID of the application",
+				RequiredForImport: true,
+			},
+			"connection_id": identityschema.StringAttribute{
+				Description:       "ID of the connection",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *ApplicationConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*kinde.Client)
+	client, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *kinde.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -101,6 +130,7 @@ func (r *ApplicationConnectionResource) Create(ctx context.Context, req resource
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, applicationConnectionIdentity(plan))...)
 }
 
 func (r *ApplicationConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -137,6 +167,7 @@ func (r *ApplicationConnectionResource) Read(ctx context.Context, req resource.R
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, applicationConnectionIdentity(state))...)
 }
 
 func (r *ApplicationConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -150,6 +181,7 @@ func (r *ApplicationConnectionResource) Update(ctx context.Context, req resource
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, applicationConnectionIdentity(plan))...)
 }
 
 func (r *ApplicationConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -171,17 +203,5 @@ func (r *ApplicationConnectionResource) Delete(ctx context.Context, req resource
 }
 
 func (r *ApplicationConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: application_id:connection_id
-	idParts, err := splitID(req.ID, 2, "application_id:connection_id")
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	importCompositeID(ctx, req, resp, "application_id", "connection_id")
 }
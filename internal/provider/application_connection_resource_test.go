@@ -9,6 +9,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccApplicationConnectionResource(t *testing.T) {
@@ -37,6 +40,34 @@ func TestAccApplicationConnectionResource(t *testing.T) {
 	})
 }
 
+func TestAccApplicationConnectionResource_Identity(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Resource identity requires Terraform 1.12
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConnectionResourceConfig(testID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("kinde_application_connection.test", tfjsonpath.New("application_id")),
+					statecheck.ExpectIdentityValueMatchesState("kinde_application_connection.test", tfjsonpath.New("connection_id")),
+				},
+			},
+			// Import with an identity instead of an ID
+			{
+				ResourceName:    "kinde_application_connection.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func testAccApplicationConnectionResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "kinde_application" "test" {
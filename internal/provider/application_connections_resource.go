@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/applications"
+)
+
+var (
+	_ resource.Resource                = &ApplicationConnectionsResource{}
+	_ resource.ResourceWithImportState = &ApplicationConnectionsResource{}
+)
+
+func NewApplicationConnectionsResource() resource.Resource {
+	return &ApplicationConnectionsResource{}
+}
+
+type ApplicationConnectionsResource struct {
+	client *applications.Client
+}
+
+type applicationConnectionsResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ApplicationID types.String `tfsdk:"application_id"`
+	ConnectionIDs types.Set    `tfsdk:"connection_ids"`
+}
+
+func (r *ApplicationConnectionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_connections"
+}
+
+func (r *ApplicationConnectionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the connections enabled for a Kinde application. Connections enabled outside of Terraform are disabled on the next apply. Do not use together with `kinde_application_connection` for the same application.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the application",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the application",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"connection_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of all connections enabled for the application",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *ApplicationConnectionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Applications
+}
+
+func (r *ApplicationConnectionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan applicationConnectionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planIDs []string
+	diags = plan.ConnectionIDs.ElementsAs(ctx, &planIDs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Connections may already be enabled, e.g. through the dashboard
+	currentIDs, err := r.getConnectionIDs(ctx, plan.ApplicationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Application Connections",
+			fmt.Sprintf("Could not read connections for application ID %s: %s", plan.ApplicationID.ValueString(), err),
+		)
+		return
+	}
+
+	if err := r.syncConnections(ctx, plan.ApplicationID.ValueString(), currentIDs, planIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Application Connections",
+			fmt.Sprintf("Could not update connections for application ID %s: %s", plan.ApplicationID.ValueString(), err),
+		)
+		return
+	}
+
+	plan.ID = plan.ApplicationID
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ApplicationConnectionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state applicationConnectionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Report every enabled connection so changes made outside of Terraform show up as drift
+	connectionIDs, err := r.getConnectionIDs(ctx, state.ApplicationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Application Connections",
+			fmt.Sprintf("Could not read connections for application ID %s: %s", state.ApplicationID.ValueString(), err),
+		)
+		return
+	}
+
+	connectionIDsSet, diags := types.SetValueFrom(ctx, types.StringType, sortStringSlice(connectionIDs))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = state.ApplicationID
+	state.ConnectionIDs = connectionIDsSet
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ApplicationConnectionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state applicationConnectionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planIDs, stateIDs []string
+	resp.Diagnostics.Append(plan.ConnectionIDs.ElementsAs(ctx, &planIDs, false)...)
+	resp.Diagnostics.Append(state.ConnectionIDs.ElementsAs(ctx, &stateIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncConnections(ctx, plan.ApplicationID.ValueString(), stateIDs, planIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Application Connections",
+			fmt.Sprintf("Could not update connections for application ID %s: %s", plan.ApplicationID.ValueString(), err),
+		)
+		return
+	}
+
+	plan.ID = plan.ApplicationID
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ApplicationConnectionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state applicationConnectionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var stateIDs []string
+	diags = state.ConnectionIDs.ElementsAs(ctx, &stateIDs, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncConnections(ctx, state.ApplicationID.ValueString(), stateIDs, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Disabling Connections",
+			fmt.Sprintf("Could not disable connections for application ID %s: %s", state.ApplicationID.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *ApplicationConnectionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: application_id
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// getConnectionIDs returns the IDs of all connections enabled for an application.
+func (r *ApplicationConnectionsResource) getConnectionIDs(ctx context.Context, applicationID string) ([]string, error) {
+	connections, err := r.client.GetConnections(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(connections))
+	for _, conn := range connections {
+		ids = append(ids, conn.ID)
+	}
+	return ids, nil
+}
+
+// syncConnections enables the connections in desired that are not in current
+// and disables the connections in current that are not in desired.
+func (r *ApplicationConnectionsResource) syncConnections(ctx context.Context, applicationID string, current, desired []string) error {
+	for _, connectionID := range stringSliceDifference(current, desired) {
+		if err := r.client.DisableConnection(ctx, applicationID, connectionID); err != nil {
+			return fmt.Errorf("could not disable connection ID %s: %w", connectionID, err)
+		}
+	}
+
+	for _, connectionID := range stringSliceDifference(desired, current) {
+		if err := r.client.EnableConnection(ctx, applicationID, connectionID); err != nil {
+			return fmt.Errorf("could not enable connection ID %s: %w", connectionID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationConnectionsResource(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApplicationConnectionsResourceConfig(testID, "kinde_connection.first.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("kinde_application_connections.test", "id", "kinde_application.test", "id"),
+					resource.TestCheckResourceAttr("kinde_application_connections.test", "connection_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("kinde_application_connections.test", "connection_ids.*", "kinde_connection.first", "id"),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccApplicationConnectionsResourceConfig(testID, "kinde_connection.second.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_application_connections.test", "connection_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("kinde_application_connections.test", "connection_ids.*", "kinde_connection.second", "id"),
+				),
+			},
+			{
+				Config: testAccApplicationConnectionsResourceConfig(testID, "kinde_connection.first.id", "kinde_connection.second.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_application_connections.test", "connection_ids.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kinde_application_connections.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApplicationConnectionsResourceConfig(name string, connectionIDs ...string) string {
+	return fmt.Sprintf(`
+resource "kinde_application" "test" {
+	name = %[1]q
+	type = "reg"
+}
+
+resource "kinde_connection" "first" {
+	name         = "%[1]s-first"
+	display_name = "Test OAuth2 Connection"
+	strategy     = "oauth2:google"
+	options = {
+		client_id     = "test-client-id"
+		client_secret = "test-client-secret"
+	}
+}
+
+resource "kinde_connection" "second" {
+	name         = "%[1]s-second"
+	display_name = "Test OAuth2 Connection"
+	strategy     = "oauth2:github"
+	options = {
+		client_id     = "test-client-id"
+		client_secret = "test-client-secret"
+	}
+}
+
+resource "kinde_application_connections" "test" {
+	application_id = kinde_application.test.id
+	connection_ids = [%[2]s]
+}
+`, name, strings.Join(connectionIDs, ", "))
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/applications"
+)
+
+var _ list.ListResourceWithConfigure = &ApplicationResource{}
+
+func NewApplicationListResource() list.ListResource {
+	return &ApplicationResource{}
+}
+
+func (r *ApplicationResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceConfigSchema("Lists all Kinde applications.")
+}
+
+func (r *ApplicationResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	apps := paginate[applications.Application](ctx, r.client, "/api/v1/applications", "applications", listPageSize)
+	stream.Results = listResults(ctx, req, "applications", apps, func(ctx context.Context, application applications.Application, result *list.ListResult) {
+		result.DisplayName = application.Name
+		setListResult(ctx, req, result, types.StringValue(application.ID), func() (applicationResourceModel, diag.Diagnostics) {
+			var state applicationResourceModel
+			var diags diag.Diagnostics
+
+			// The application list does not include client credentials
+			app, err := r.client.Get(ctx, application.ID)
+			if err != nil {
+				diags.AddError(
+					"Error Reading Application",
+					fmt.Sprintf("Could not read application ID %s: %s", application.ID, err),
+				)
+				return state, diags
+			}
+
+			flattenApplicationResourceModel(app, &state)
+			return state, diags
+		})
+	})
+}
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,13 +15,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nxt-fwd/kinde-go"
 	"github.com/nxt-fwd/kinde-go/api/applications"
 )
 
 var (
 	_ resource.Resource                = &ApplicationResource{}
 	_ resource.ResourceWithImportState = &ApplicationResource{}
+	_ resource.ResourceWithIdentity    = &ApplicationResource{}
 )
 
 func NewApplicationResource() resource.Resource {
@@ -32,15 +33,46 @@ type ApplicationResource struct {
 }
 
 type applicationResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Type         types.String `tfsdk:"type"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	LoginURI     types.String `tfsdk:"login_uri"`
-	HomepageURI  types.String `tfsdk:"homepage_uri"`
-	LogoutURIs   types.List   `tfsdk:"logout_uris"`
-	RedirectURIs types.List   `tfsdk:"redirect_uris"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	ClientID            types.String `tfsdk:"client_id"`
+	ClientSecret        types.String `tfsdk:"client_secret"`
+	LoginURI            types.String `tfsdk:"login_uri"`
+	HomepageURI         types.String `tfsdk:"homepage_uri"`
+	LogoutURIs          types.List   `tfsdk:"logout_uris"`
+	RedirectURIs        types.List   `tfsdk:"redirect_uris"`
+	RotateSecretTrigger types.String `tfsdk:"rotate_secret_trigger"`
+}
+
+// rotateSecretModifier marks client_secret as unknown whenever
+// rotate_secret_trigger changes, so the plan reflects the upcoming rotation.
+type rotateSecretModifier struct{}
+
+func (m rotateSecretModifier) Description(ctx context.Context) string {
+	return "Marks the client secret as unknown when rotate_secret_trigger changes."
+}
+
+func (m rotateSecretModifier) MarkdownDescription(ctx context.Context) string {
+	return "Marks the client secret as unknown when `rotate_secret_trigger` changes."
+}
+
+func (m rotateSecretModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to rotate on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planTrigger, stateTrigger types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotate_secret_trigger"), &planTrigger)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotate_secret_trigger"), &stateTrigger)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planTrigger.Equal(stateTrigger) {
+		resp.PlanValue = types.StringUnknown()
+	}
 }
 
 func (r *ApplicationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -75,7 +107,10 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Client secret of the application",
 				Computed:            true,
 				Sensitive:           true,
-				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					rotateSecretModifier{},
+				},
 			},
 			"login_uri": schema.StringAttribute{
 				Description: "The login URI of the application.",
@@ -101,20 +136,28 @@ func (r *ApplicationResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"rotate_secret_trigger": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value that rotates the client secret whenever it changes, e.g. a date or a version number. The client id is kept. Setting it on create has no effect since a new application already gets a fresh secret.",
+				Optional:            true,
+			},
 		},
 	}
 }
 
+func (r *ApplicationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIDIdentitySchema("ID of the application")
+}
+
 func (r *ApplicationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*kinde.Client)
+	client, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *kinde.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -208,6 +251,7 @@ func (r *ApplicationResource) Create(ctx context.Context, req resource.CreateReq
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(plan.ID))...)
 
 	tflog.Debug(ctx, "Application creation completed")
 }
@@ -229,37 +273,11 @@ func (r *ApplicationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	// Set all values from application data
-	state.ID = types.StringValue(app.ID)
-	state.Name = types.StringValue(app.Name)
-	state.Type = types.StringValue(string(app.Type))
-	state.ClientID = types.StringValue(app.ClientID)
-	state.ClientSecret = types.StringValue(app.ClientSecret)
-
-	// Set optional values
-	if app.LoginURI != "" {
-		state.LoginURI = types.StringValue(app.LoginURI)
-	} else {
-		state.LoginURI = types.StringNull()
-	}
-
-	if app.HomepageURI != "" {
-		state.HomepageURI = types.StringValue(app.HomepageURI)
-	} else {
-		state.HomepageURI = types.StringNull()
-	}
-
-	// Handle URI lists
-	// Keep logout and redirect URIs from state as they are not returned by the API
-	if state.LogoutURIs.IsNull() {
-		state.LogoutURIs = types.ListNull(types.StringType)
-	}
-	if state.RedirectURIs.IsNull() {
-		state.RedirectURIs = types.ListNull(types.StringType)
-	}
+	flattenApplicationResourceModel(app, &state)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 }
 
 func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -322,8 +340,23 @@ func (r *ApplicationResource) Update(ctx context.Context, req resource.UpdateReq
 		tflog.Debug(ctx, "Application settings updated successfully")
 	}
 
+	if !plan.RotateSecretTrigger.Equal(state.RotateSecretTrigger) {
+		clientSecret, err := r.rotateSecret(ctx, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Rotating Application Secret",
+				fmt.Sprintf("Could not rotate client secret for application ID %s: %s", plan.ID.ValueString(), err),
+			)
+			return
+		}
+
+		plan.ClientSecret = types.StringValue(clientSecret)
+		tflog.Debug(ctx, "Application client secret rotated")
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(plan.ID))...)
 }
 
 func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -344,6 +377,29 @@ func (r *ApplicationResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// rotateSecret rotates the client secret of the application and returns the
+// new secret. The rotate endpoint is not covered by the applications client,
+// so the request is issued directly.
+func (r *ApplicationResource) rotateSecret(ctx context.Context, id string) (string, error) {
+	endpoint := fmt.Sprintf("/api/v1/applications/%s/rotate_secret", id)
+	request, err := r.client.NewRequest(ctx, http.MethodPost, endpoint, nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.client.DoRequest(request, nil); err != nil {
+		return "", err
+	}
+
+	// Read the application back to pick up the new secret
+	app, err := r.client.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	return app.ClientSecret, nil
+}
+
 func (r *ApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -88,6 +88,61 @@ func TestAccApplicationResource_Connections(t *testing.T) {
 	})
 }
 
+func TestAccApplicationResource_RotateSecret(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+	var clientID, clientSecret string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationResourceConfig_RotateSecret(testID, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_application.test", "rotate_secret_trigger", "1"),
+					resource.TestCheckResourceAttrWith("kinde_application.test", "client_id", func(value string) error {
+						clientID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("kinde_application.test", "client_secret", func(value string) error {
+						clientSecret = value
+						return nil
+					}),
+				),
+			},
+			// Changing the trigger rotates the secret but keeps the client id
+			{
+				Config: testAccApplicationResourceConfig_RotateSecret(testID, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_application.test", "rotate_secret_trigger", "2"),
+					resource.TestCheckResourceAttrWith("kinde_application.test", "client_id", func(value string) error {
+						if value != clientID {
+							return fmt.Errorf("expected client_id to stay %s, got %s", clientID, value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("kinde_application.test", "client_secret", func(value string) error {
+						if value == clientSecret {
+							return fmt.Errorf("expected client_secret to be rotated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationResourceConfig_RotateSecret(name string, trigger string) string {
+	return fmt.Sprintf(`
+resource "kinde_application" "test" {
+	name                  = %[1]q
+	type                  = "m2m"
+	rotate_secret_trigger = %[2]q
+}
+`, name, trigger)
+}
+
 func testAccApplicationResourceConfig_WithConnections(name string) string {
 	return fmt.Sprintf(`
 data "kinde_connections" "builtin" {
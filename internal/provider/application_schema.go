@@ -79,6 +79,37 @@ func flattenApplicationResource(ctx context.Context, resource *applications.Appl
 	return model, diags
 }
 
+// flattenApplicationResourceModel sets the attributes of the model that are
+// returned by the applications client. Logout and redirect URIs are not
+// returned by the API, so they are kept from the model.
+func flattenApplicationResourceModel(app *applications.Application, model *applicationResourceModel) {
+	model.ID = types.StringValue(app.ID)
+	model.Name = types.StringValue(app.Name)
+	model.Type = types.StringValue(string(app.Type))
+	model.ClientID = types.StringValue(app.ClientID)
+	model.ClientSecret = types.StringValue(app.ClientSecret)
+
+	// Set optional values
+	if app.LoginURI != "" {
+		model.LoginURI = types.StringValue(app.LoginURI)
+	} else {
+		model.LoginURI = types.StringNull()
+	}
+
+	if app.HomepageURI != "" {
+		model.HomepageURI = types.StringValue(app.HomepageURI)
+	} else {
+		model.HomepageURI = types.StringNull()
+	}
+
+	if model.LogoutURIs.IsNull() {
+		model.LogoutURIs = types.ListNull(types.StringType)
+	}
+	if model.RedirectURIs.IsNull() {
+		model.RedirectURIs = types.ListNull(types.StringType)
+	}
+}
+
 type ApplicationDataSourceModel struct {
 	ID           types.String `tfsdk:"id"`
 	Name         types.String `tfsdk:"name"`
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/nxt-fwd/kinde-go/api/connections"
+)
+
+var _ list.ListResourceWithConfigure = &ConnectionResource{}
+
+func NewConnectionListResource() list.ListResource {
+	return &ConnectionResource{}
+}
+
+func (r *ConnectionResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceConfigSchema("Lists all Kinde connections. Connection options are not returned by the API and are not included.")
+}
+
+func (r *ConnectionResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	conns, err := r.cache.Connections(ctx)
+	stream.Results = listResults(ctx, req, "connections", sliceItems(conns, err), func(ctx context.Context, conn connections.Connection, result *list.ListResult) {
+		var state ConnectionResourceModel
+		flattenConnectionResource(&conn, &state)
+
+		result.DisplayName = conn.DisplayName
+		setListResult(ctx, req, result, state.ID, func() (ConnectionResourceModel, diag.Diagnostics) {
+			return state, nil
+		})
+	})
+}
@@ -5,19 +5,22 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/nxt-fwd/kinde-go"
 	"github.com/nxt-fwd/kinde-go/api/connections"
 )
 
 var (
-	_ resource.Resource                = &ConnectionResource{}
-	_ resource.ResourceWithImportState = &ConnectionResource{}
+	_ resource.Resource                   = &ConnectionResource{}
+	_ resource.ResourceWithImportState    = &ConnectionResource{}
+	_ resource.ResourceWithIdentity       = &ConnectionResource{}
+	_ resource.ResourceWithValidateConfig = &ConnectionResource{}
 )
 
 func NewConnectionResource() resource.Resource {
@@ -26,12 +29,15 @@ func NewConnectionResource() resource.Resource {
 
 type ConnectionResource struct {
 	client *connections.Client
+	cache  *providerCache
 }
 
 // ConnectionOptionsModel represents OAuth2 connection options
 type ConnectionOptionsModel struct {
-	ClientID     types.String `tfsdk:"client_id" json:"client_id,omitempty"`
-	ClientSecret types.String `tfsdk:"client_secret" json:"client_secret,omitempty"`
+	ClientID              types.String `tfsdk:"client_id" json:"client_id,omitempty"`
+	ClientSecret          types.String `tfsdk:"client_secret" json:"client_secret,omitempty"`
+	ClientSecretWO        types.String `tfsdk:"client_secret_wo" json:"-"`
+	ClientSecretWOVersion types.Int64  `tfsdk:"client_secret_wo_version" json:"-"`
 }
 
 // IsEmpty returns true if both fields are null or empty
@@ -45,16 +51,26 @@ func (m *ConnectionOptionsModel) IsEmpty() bool {
 	return isClientIDEmpty && isClientSecretEmpty
 }
 
-// Validate ensures both fields are either both set or both null
+// Validate ensures both fields are either both set or both null. The secret
+// may be given either as client_secret or as the write-only client_secret_wo.
 func (m *ConnectionOptionsModel) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	if !m.ClientSecret.IsNull() && !m.ClientSecretWO.IsNull() {
+		return fmt.Errorf("only one of client_secret and client_secret_wo can be set")
+	}
+
+	if !m.ClientSecretWOVersion.IsNull() && m.ClientSecretWO.IsNull() {
+		return fmt.Errorf("client_secret_wo_version can only be set together with client_secret_wo")
+	}
+
+	hasSecret := !m.ClientSecret.IsNull() || !m.ClientSecretWO.IsNull()
+
 	// If either field is set, both must be set
-	if (!m.ClientID.IsNull() || !m.ClientSecret.IsNull()) &&
-		(m.ClientID.IsNull() || m.ClientSecret.IsNull()) {
-		return fmt.Errorf("both client_id and client_secret must be set if either is provided")
+	if (!m.ClientID.IsNull() || hasSecret) && (m.ClientID.IsNull() || !hasSecret) {
+		return fmt.Errorf("both client_id and client_secret (or client_secret_wo) must be set if either is provided")
 	}
 
 	return nil
@@ -73,6 +89,10 @@ func (m *ConnectionOptionsModel) ToAPIOptions() connections.SocialConnectionOpti
 	if !m.ClientSecret.IsNull() {
 		opts.ClientSecret = m.ClientSecret.ValueString()
 	}
+	// The write-only secret is only ever present when read from config
+	if !m.ClientSecretWO.IsNull() {
+		opts.ClientSecret = m.ClientSecretWO.ValueString()
+	}
 	return opts
 }
 
@@ -83,6 +103,10 @@ type ConnectionResourceModel struct {
 	DisplayName types.String            `tfsdk:"display_name"`
 	Strategy    types.String            `tfsdk:"strategy"`
 	Options     *ConnectionOptionsModel `tfsdk:"options"`
+
+	SAMLOptions    *ConnectionSAMLOptionsModel    `tfsdk:"saml_options"`
+	EntraIDOptions *ConnectionEntraIDOptionsModel `tfsdk:"entra_id_options"`
+	OIDCOptions    *ConnectionOIDCOptionsModel    `tfsdk:"oidc_options"`
 }
 
 // Equal compares two ConnectionResourceModel instances
@@ -181,11 +205,11 @@ func (r *ConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:            true,
 			},
 			"strategy": schema.StringAttribute{
-				MarkdownDescription: "Strategy of the connection",
+				MarkdownDescription: "Strategy of the connection, e.g. `oauth2:google`, `saml:custom`, `wsfed:azure_ad` or `oidc:custom`",
 				Required:            true,
 			},
 			"options": schema.SingleNestedAttribute{
-				MarkdownDescription: "Options for the connection. Required for OAuth2 connections. Sensitive values are stored in state and rely on state encryption for security.",
+				MarkdownDescription: "Options for the connection. Required for OAuth2 connections. `client_secret` is stored in state and relies on state encryption for security; on Terraform 1.11 and later use `client_secret_wo` instead to keep the secret out of state entirely.",
 				Optional:            true,
 				PlanModifiers:       []planmodifier.Object{&optionsEmptyPreserveModifier{}},
 				Attributes: map[string]schema.Attribute{
@@ -197,27 +221,198 @@ func (r *ConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 						Optional:  true,
 						Sensitive: true,
 					},
+					"client_secret_wo": schema.StringAttribute{
+						MarkdownDescription: "Write-only client secret. It is sent to Kinde on create and update but never persisted to plan or state. Conflicts with `client_secret`. Requires Terraform 1.11 or later.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"client_secret_wo_version": schema.Int64Attribute{
+						MarkdownDescription: "Version of `client_secret_wo`. Since write-only values are not stored, Terraform cannot detect changes to them; increment this value to send an updated secret.",
+						Optional:            true,
+					},
+				},
+			},
+			"saml_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Options for enterprise SAML connections. Only valid with the `saml:custom` strategy. Like `options`, these values are stored in state and kept when removed from configuration since the API never returns them.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{&optionsEmptyPreserveModifier{}},
+				Attributes: map[string]schema.Attribute{
+					"entity_id": schema.StringAttribute{
+						MarkdownDescription: "Entity ID that identifies Kinde to the identity provider",
+						Required:            true,
+					},
+					"sign_in_url": schema.StringAttribute{
+						MarkdownDescription: "Sign-in URL of the identity provider. Either this or `idp_metadata_url` must be set.",
+						Optional:            true,
+					},
+					"idp_metadata_url": schema.StringAttribute{
+						MarkdownDescription: "Metadata URL of the identity provider. Either this or `sign_in_url` must be set.",
+						Optional:            true,
+					},
+					"acs_url": schema.StringAttribute{
+						MarkdownDescription: "Assertion consumer service URL",
+						Optional:            true,
+					},
+					"signing_certificate": schema.StringAttribute{
+						MarkdownDescription: "PEM encoded certificate used to sign requests",
+						Optional:            true,
+					},
+					"signing_private_key": schema.StringAttribute{
+						MarkdownDescription: "PEM encoded private key matching `signing_certificate`",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"email_key_attribute": schema.StringAttribute{
+						MarkdownDescription: "SAML attribute that holds the email of the user",
+						Optional:            true,
+					},
+					"first_name_key_attribute": schema.StringAttribute{
+						MarkdownDescription: "SAML attribute that holds the first name of the user",
+						Optional:            true,
+					},
+					"last_name_key_attribute": schema.StringAttribute{
+						MarkdownDescription: "SAML attribute that holds the last name of the user",
+						Optional:            true,
+					},
+					"home_realm_domains": schema.ListAttribute{
+						MarkdownDescription: "Email domains that are routed to this connection",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"create_missing_user": schema.BoolAttribute{
+						MarkdownDescription: "Whether users that do not exist in Kinde yet are created on sign in",
+						Optional:            true,
+					},
+				},
+			},
+			"entra_id_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Options for Microsoft Entra ID (Azure AD) enterprise connections. Only valid with the `wsfed:azure_ad` strategy. Like `options`, these values are stored in state and kept when removed from configuration since the API never returns them.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{&optionsEmptyPreserveModifier{}},
+				Attributes: map[string]schema.Attribute{
+					"domain": schema.StringAttribute{
+						MarkdownDescription: "Entra ID tenant domain, e.g. `example.onmicrosoft.com`. Required unless `use_common_endpoint` is true.",
+						Optional:            true,
+					},
+					"use_common_endpoint": schema.BoolAttribute{
+						MarkdownDescription: "Whether to sign in through the multi-tenant common endpoint instead of a single tenant",
+						Optional:            true,
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Client id of the Entra ID application registration",
+						Required:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "Client secret of the Entra ID application registration. Either this or `client_secret_wo` must be set.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"client_secret_wo": schema.StringAttribute{
+						MarkdownDescription: "Write-only client secret of the Entra ID application registration. It is sent to Kinde on create and update but never persisted to plan or state. Conflicts with `client_secret`. Requires Terraform 1.11 or later.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"client_secret_wo_version": schema.Int64Attribute{
+						MarkdownDescription: "Version of `client_secret_wo`. Since write-only values are not stored, Terraform cannot detect changes to them; increment this value to send an updated secret.",
+						Optional:            true,
+					},
+					"home_realm_domains": schema.ListAttribute{
+						MarkdownDescription: "Email domains that are routed to this connection",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"sync_user_profile_on_login": schema.BoolAttribute{
+						MarkdownDescription: "Whether the user profile is synced from Entra ID on every sign in",
+						Optional:            true,
+					},
+					"retrieve_provider_user_groups": schema.BoolAttribute{
+						MarkdownDescription: "Whether the groups of the user are retrieved from Entra ID",
+						Optional:            true,
+					},
+					"extended_attributes_required": schema.BoolAttribute{
+						MarkdownDescription: "Whether extended user attributes are requested from Entra ID",
+						Optional:            true,
+					},
+				},
+			},
+			"oidc_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Options for custom OpenID Connect connections. Only valid with the `oidc:custom` strategy. Either `issuer_url`, `discovery_url` or both `authorization_endpoint` and `token_endpoint` must be set. Like `options`, these values are stored in state and kept when removed from configuration since the API never returns them.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Object{&optionsEmptyPreserveModifier{}},
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "Client id registered with the identity provider",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "Client secret registered with the identity provider",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"issuer_url": schema.StringAttribute{
+						MarkdownDescription: "Issuer URL of the identity provider, used to discover its endpoints",
+						Optional:            true,
+					},
+					"discovery_url": schema.StringAttribute{
+						MarkdownDescription: "URL of the OpenID configuration document. Defaults to the well-known location below `issuer_url`.",
+						Optional:            true,
+					},
+					"authorization_endpoint": schema.StringAttribute{
+						MarkdownDescription: "Authorization endpoint, overrides the discovered value",
+						Optional:            true,
+					},
+					"token_endpoint": schema.StringAttribute{
+						MarkdownDescription: "Token endpoint, overrides the discovered value",
+						Optional:            true,
+					},
+					"userinfo_endpoint": schema.StringAttribute{
+						MarkdownDescription: "Userinfo endpoint, overrides the discovered value",
+						Optional:            true,
+					},
+					"scopes": schema.ListAttribute{
+						MarkdownDescription: "Scopes requested from the identity provider, e.g. `openid`, `profile` and `email`",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"claim_mappings": schema.MapAttribute{
+						MarkdownDescription: "Maps Kinde user attributes to claims of the identity provider. Supported keys are `email`, `family_name`, `given_name`, `picture` and `username`.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"home_realm_domains": schema.ListAttribute{
+						MarkdownDescription: "Email domains that are routed to this connection",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
 				},
 			},
 		},
 	}
 }
 
+func (r *ConnectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIDIdentitySchema("ID of the connection")
+}
+
 func (r *ConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*kinde.Client)
+	client, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *kinde.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	r.client = client.Connections
+	r.cache = client.cache
 }
 
 func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -228,18 +423,19 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	resp.Diagnostics.Append(r.applyWriteOnlySecret(ctx, req.Config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert options to map for API
-	var options interface{}
-	if plan.Options != nil {
-		var err error
-		options, err = r.convertOptionsToMap(plan.Strategy.ValueString(), plan.Options)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Converting Options",
-				fmt.Sprintf("Could not convert options: %s", err),
-			)
-			return
-		}
+	options, err := r.convertOptionsToMap(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Converting Options",
+			fmt.Sprintf("Could not convert options: %s", err),
+		)
+		return
 	}
 
 	// Create connection
@@ -259,6 +455,8 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	r.cache.InvalidateConnections()
+
 	// Set ID from response, keep other fields from plan including options
 	plan.ID = types.StringValue(conn.ID)
 
@@ -266,6 +464,7 @@ func (r *ConnectionResource) Create(ctx context.Context, req resource.CreateRequ
 	// We'll rely on state encryption for security
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(plan.ID))...)
 }
 
 func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -276,7 +475,7 @@ func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	conn, err := r.client.Get(ctx, state.ID.ValueString())
+	conn, err := r.readConnection(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Connection",
@@ -285,17 +484,32 @@ func (r *ConnectionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// Set basic fields from API response
-	state.ID = types.StringValue(conn.ID)
-	state.Name = types.StringValue(conn.Name)
-	state.DisplayName = types.StringValue(conn.DisplayName)
-	state.Strategy = types.StringValue(conn.Strategy)
+	flattenConnectionResource(conn, &state)
 
 	// API doesn't return sensitive options, so preserve them from state
 	// We're relying on state encryption for security
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
+}
+
+// readConnection returns the connection from the cached connection list, so
+// reading many connections costs a single request.
+func (r *ConnectionResource) readConnection(ctx context.Context, id string) (*connections.Connection, error) {
+	conns, err := r.cache.Connections(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, conn := range conns {
+		if conn.ID == id {
+			return &conn, nil
+		}
+	}
+
+	// Let the API report connections that are not listed
+	return r.client.Get(ctx, id)
 }
 
 func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -306,18 +520,19 @@ func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	resp.Diagnostics.Append(r.applyWriteOnlySecret(ctx, req.Config, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert options to map for API
-	var options interface{}
-	if plan.Options != nil {
-		var err error
-		options, err = r.convertOptionsToMap(plan.Strategy.ValueString(), plan.Options)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Converting Options",
-				fmt.Sprintf("Could not convert options: %s", err),
-			)
-			return
-		}
+	options, err := r.convertOptionsToMap(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Converting Options",
+			fmt.Sprintf("Could not convert options: %s", err),
+		)
+		return
 	}
 
 	// Update connection
@@ -327,7 +542,7 @@ func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		Options:     options,
 	}
 
-	_, err := r.client.Update(ctx, plan.ID.ValueString(), updateParams)
+	_, err = r.client.Update(ctx, plan.ID.ValueString(), updateParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Connection",
@@ -336,10 +551,13 @@ func (r *ConnectionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	r.cache.InvalidateConnections()
+
 	// Store plan in state, including options with sensitive values
 	// We'll rely on state encryption for security
 	diags := resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(plan.ID))...)
 }
 
 func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -358,12 +576,14 @@ func (r *ConnectionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		)
 		return
 	}
+
+	r.cache.InvalidateConnections()
 }
 
 func (r *ConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import just the ID, the Read method will handle the rest
 	// Note that sensitive options won't be imported and will need to be set in configuration
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 
 	// Add a warning about sensitive values
 	resp.Diagnostics.AddWarning(
@@ -373,11 +593,33 @@ func (r *ConnectionResource) ImportState(ctx context.Context, req resource.Impor
 	)
 }
 
-func (r *ConnectionResource) convertOptionsToMap(strategy string, options *ConnectionOptionsModel) (interface{}, error) {
-	if options == nil {
-		return map[string]interface{}{}, nil
+// applyWriteOnlySecret copies client_secret_wo of options and
+// entra_id_options from config into the plan. Write-only values are always
+// null in the plan, so they have to be read from config before options are
+// sent to the API. The framework nullifies the value again before the state is
+// persisted.
+func (r *ConnectionResource) applyWriteOnlySecret(ctx context.Context, config tfsdk.Config, plan *ConnectionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.Options != nil {
+		diags.Append(config.GetAttribute(ctx, path.Root("options").AtName("client_secret_wo"), &plan.Options.ClientSecretWO)...)
+	}
+
+	if plan.EntraIDOptions != nil {
+		diags.Append(config.GetAttribute(ctx, path.Root("entra_id_options").AtName("client_secret_wo"), &plan.EntraIDOptions.ClientSecretWO)...)
+	}
+
+	return diags
+}
+
+func (r *ConnectionResource) convertOptionsToMap(ctx context.Context, data *ConnectionResourceModel) (interface{}, error) {
+	// Connections without any options, e.g. built-in strategies, send none
+	if data.Options == nil && data.SAMLOptions == nil && data.EntraIDOptions == nil && data.OIDCOptions == nil {
+		return nil, nil
 	}
 
+	strategy := data.Strategy.ValueString()
+
 	switch connections.Strategy(strategy) {
 	case connections.StrategyOAuth2Apple,
 		connections.StrategyOAuth2AzureAD,
@@ -395,7 +637,28 @@ func (r *ConnectionResource) convertOptionsToMap(strategy string, options *Conne
 		connections.StrategyOAuth2Twitch,
 		connections.StrategyOAuth2Twitter,
 		connections.StrategyOAuth2Xero:
-		return options.ToAPIOptions(), nil
+		if data.Options == nil {
+			return map[string]interface{}{}, nil
+		}
+		return data.Options.ToAPIOptions(), nil
+
+	case connections.StrategySAMLCustom:
+		if data.SAMLOptions == nil {
+			return nil, fmt.Errorf("saml_options must be set for strategy %s", strategy)
+		}
+		return data.SAMLOptions.ToAPIOptions(ctx)
+
+	case connections.StrategyWSFedAzureAD:
+		if data.EntraIDOptions == nil {
+			return nil, fmt.Errorf("entra_id_options must be set for strategy %s", strategy)
+		}
+		return data.EntraIDOptions.ToAPIOptions(ctx)
+
+	case strategyOIDCCustom:
+		if data.OIDCOptions == nil {
+			return nil, fmt.Errorf("oidc_options must be set for strategy %s", strategy)
+		}
+		return data.OIDCOptions.ToAPIOptions(ctx)
 
 	default:
 		return nil, fmt.Errorf("unsupported strategy: %s", strategy)
@@ -410,18 +673,85 @@ func (r *ConnectionResource) ValidateConfig(ctx context.Context, req resource.Va
 	}
 
 	// Validate strategy
-	if !data.Strategy.IsNull() {
-		strategy := connections.Strategy(data.Strategy.ValueString())
-		if strings.HasPrefix(string(strategy), "oauth2:") {
-			// Validate options if present
-			if data.Options != nil {
-				if err := data.Options.Validate(); err != nil {
-					resp.Diagnostics.AddError(
-						"Invalid Options Configuration",
-						err.Error(),
-					)
-				}
+	if data.Strategy.IsNull() || data.Strategy.IsUnknown() {
+		return
+	}
+
+	strategy := connections.Strategy(data.Strategy.ValueString())
+	isOAuth2 := strings.HasPrefix(string(strategy), "oauth2:")
+
+	if data.Options != nil && !isOAuth2 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("options"),
+			"Invalid Options Configuration",
+			fmt.Sprintf("options can only be used with oauth2 strategies, got %s.", strategy),
+		)
+	}
+
+	if data.SAMLOptions != nil && strategy != connections.StrategySAMLCustom {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("saml_options"),
+			"Invalid Options Configuration",
+			fmt.Sprintf("saml_options can only be used with the %s strategy, got %s.", connections.StrategySAMLCustom, strategy),
+		)
+	}
+
+	if data.EntraIDOptions != nil && strategy != connections.StrategyWSFedAzureAD {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("entra_id_options"),
+			"Invalid Options Configuration",
+			fmt.Sprintf("entra_id_options can only be used with the %s strategy, got %s.", connections.StrategyWSFedAzureAD, strategy),
+		)
+	}
+
+	if data.OIDCOptions != nil && strategy != strategyOIDCCustom {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oidc_options"),
+			"Invalid Options Configuration",
+			fmt.Sprintf("oidc_options can only be used with the %s strategy, got %s.", strategyOIDCCustom, strategy),
+		)
+	}
+
+	switch {
+	case isOAuth2:
+		// Validate options if present
+		if data.Options != nil {
+			if err := data.Options.Validate(); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("options"), "Invalid Options Configuration", err.Error())
 			}
 		}
+
+	case strategy == connections.StrategySAMLCustom:
+		if data.SAMLOptions == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("saml_options"),
+				"Missing Options Configuration",
+				fmt.Sprintf("saml_options must be set for the %s strategy.", strategy),
+			)
+		} else if err := data.SAMLOptions.Validate(); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("saml_options"), "Invalid Options Configuration", err.Error())
+		}
+
+	case strategy == connections.StrategyWSFedAzureAD:
+		if data.EntraIDOptions == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entra_id_options"),
+				"Missing Options Configuration",
+				fmt.Sprintf("entra_id_options must be set for the %s strategy.", strategy),
+			)
+		} else if err := data.EntraIDOptions.Validate(); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("entra_id_options"), "Invalid Options Configuration", err.Error())
+		}
+
+	case strategy == strategyOIDCCustom:
+		if data.OIDCOptions == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("oidc_options"),
+				"Missing Options Configuration",
+				fmt.Sprintf("oidc_options must be set for the %s strategy.", strategy),
+			)
+		} else if err := data.OIDCOptions.Validate(); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("oidc_options"), "Invalid Options Configuration", err.Error())
+		}
 	}
 }
@@ -2,10 +2,13 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/nxt-fwd/kinde-go/api/connections"
 )
 
@@ -256,6 +259,238 @@ func TestAccConnectionResource_EmptyToPopulatedOptions(t *testing.T) {
 	})
 }
 
+func TestAccConnectionResource_WriteOnlySecret(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// write-only attributes require Terraform 1.11
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionResourceConfig_WriteOnlySecret(testID, "test-client-secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_connection.oauth2", "options.client_id", "test-client-id"),
+					resource.TestCheckNoResourceAttr("kinde_connection.oauth2", "options.client_secret"),
+					resource.TestCheckNoResourceAttr("kinde_connection.oauth2", "options.client_secret_wo"),
+					resource.TestCheckResourceAttr("kinde_connection.oauth2", "options.client_secret_wo_version", "1"),
+				),
+			},
+			// Bumping the version sends the new secret
+			{
+				Config: testAccConnectionResourceConfig_WriteOnlySecret(testID, "rotated-client-secret", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("kinde_connection.oauth2", "options.client_secret_wo"),
+					resource.TestCheckResourceAttr("kinde_connection.oauth2", "options.client_secret_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionResource_SAML(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionResourceConfig_SAML(testID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_connection.saml", "strategy", string(connections.StrategySAMLCustom)),
+					resource.TestCheckResourceAttr("kinde_connection.saml", "saml_options.entity_id", testID),
+					resource.TestCheckResourceAttr("kinde_connection.saml", "saml_options.home_realm_domains.0", "example.com"),
+					resource.TestCheckResourceAttrSet("kinde_connection.saml", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionResource_EntraID(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionResourceConfig_EntraID(testID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_connection.entra_id", "strategy", string(connections.StrategyWSFedAzureAD)),
+					resource.TestCheckResourceAttr("kinde_connection.entra_id", "entra_id_options.domain", "example.onmicrosoft.com"),
+					resource.TestCheckResourceAttr("kinde_connection.entra_id", "entra_id_options.client_id", "test-client-id"),
+					resource.TestCheckResourceAttrSet("kinde_connection.entra_id", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionResource_EntraIDWriteOnlySecret(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// write-only attributes require Terraform 1.11
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionResourceConfig_EntraIDWriteOnlySecret(testID, "test-client-secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_connection.entra_id", "entra_id_options.client_id", "test-client-id"),
+					resource.TestCheckNoResourceAttr("kinde_connection.entra_id", "entra_id_options.client_secret"),
+					resource.TestCheckNoResourceAttr("kinde_connection.entra_id", "entra_id_options.client_secret_wo"),
+					resource.TestCheckResourceAttr("kinde_connection.entra_id", "entra_id_options.client_secret_wo_version", "1"),
+				),
+			},
+			// Bumping the version sends the new secret
+			{
+				Config: testAccConnectionResourceConfig_EntraIDWriteOnlySecret(testID, "rotated-client-secret", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("kinde_connection.entra_id", "entra_id_options.client_secret_wo"),
+					resource.TestCheckResourceAttr("kinde_connection.entra_id", "entra_id_options.client_secret_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionResource_EnterpriseValidation(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// SAML connections need to know how to reach the identity provider
+			{
+				Config: fmt.Sprintf(`
+resource "kinde_connection" "saml" {
+	name         = %[1]q
+	display_name = "Test SAML Connection"
+	strategy     = "saml:custom"
+	saml_options = {
+		entity_id = %[1]q
+	}
+}
+`, testID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`either idp_metadata_url or sign_in_url must be set`),
+			},
+			// Options blocks must match the strategy
+			{
+				Config: fmt.Sprintf(`
+resource "kinde_connection" "entra_id" {
+	name         = %[1]q
+	display_name = "Test Entra ID Connection"
+	strategy     = "oauth2:google"
+	entra_id_options = {
+		domain        = "example.onmicrosoft.com"
+		client_id     = "test-client-id"
+		client_secret = "test-client-secret"
+	}
+}
+`, testID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`entra_id_options can only be used with the wsfed:azure_ad strategy`),
+			},
+		},
+	})
+}
+
+func TestAccConnectionResource_OIDC(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionResourceConfig_OIDC(testID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_connection.oidc", "strategy", "oidc:custom"),
+					resource.TestCheckResourceAttr("kinde_connection.oidc", "oidc_options.issuer_url", "https://idp.example.com"),
+					resource.TestCheckResourceAttr("kinde_connection.oidc", "oidc_options.scopes.#", "3"),
+					resource.TestCheckResourceAttr("kinde_connection.oidc", "oidc_options.claim_mappings.email", "mail"),
+					resource.TestCheckResourceAttr("kinde_connection.oidc", "oidc_options.client_secret", "test-client-secret"),
+					resource.TestCheckResourceAttrSet("kinde_connection.oidc", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccConnectionResource_OIDCValidation(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Endpoints must be discoverable or configured explicitly
+			{
+				Config: fmt.Sprintf(`
+resource "kinde_connection" "oidc" {
+	name         = %[1]q
+	display_name = "Test OIDC Connection"
+	strategy     = "oidc:custom"
+	oidc_options = {
+		client_id      = "test-client-id"
+		client_secret  = "test-client-secret"
+		token_endpoint = "https://idp.example.com/token"
+	}
+}
+`, testID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`either issuer_url, discovery_url or both authorization_endpoint and`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "kinde_connection" "oidc" {
+	name         = %[1]q
+	display_name = "Test OIDC Connection"
+	strategy     = "oidc:custom"
+	oidc_options = {
+		client_id     = "test-client-id"
+		client_secret = "test-client-secret"
+		issuer_url    = "http://idp.example.com"
+	}
+}
+`, testID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`issuer_url must be an absolute https URL`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "kinde_connection" "oidc" {
+	name         = %[1]q
+	display_name = "Test OIDC Connection"
+	strategy     = "oidc:custom"
+	oidc_options = {
+		client_id      = "test-client-id"
+		client_secret  = "test-client-secret"
+		issuer_url     = "https://idp.example.com"
+		claim_mappings = {
+			phone = "phone_number"
+		}
+	}
+}
+`, testID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`unsupported claim mapping "phone"`),
+			},
+		},
+	})
+}
+
 func testAccConnectionResourceConfig_OAuth2(name string) string {
 	return fmt.Sprintf(`
 resource "kinde_connection" "oauth2" {
@@ -395,3 +630,88 @@ resource "kinde_connection" "empty_to_populated" {
 }
 `, name)
 }
+
+func testAccConnectionResourceConfig_WriteOnlySecret(name, clientSecret string, secretVersion int) string {
+	return fmt.Sprintf(`
+resource "kinde_connection" "oauth2" {
+	name         = %[1]q
+	display_name = "Test OAuth2 Connection"
+	strategy     = "oauth2:google"
+	options = {
+		client_id                = "test-client-id"
+		client_secret_wo         = %[2]q
+		client_secret_wo_version = %[3]d
+	}
+}
+`, name, clientSecret, secretVersion)
+}
+
+func testAccConnectionResourceConfig_SAML(name string) string {
+	return fmt.Sprintf(`
+resource "kinde_connection" "saml" {
+	name         = %[1]q
+	display_name = "Test SAML Connection"
+	strategy     = "saml:custom"
+	saml_options = {
+		entity_id                = %[1]q
+		idp_metadata_url         = "https://idp.example.com/metadata"
+		email_key_attribute      = "email"
+		first_name_key_attribute = "given_name"
+		last_name_key_attribute  = "family_name"
+		home_realm_domains       = ["example.com"]
+		create_missing_user      = true
+	}
+}
+`, name)
+}
+
+func testAccConnectionResourceConfig_EntraID(name string) string {
+	return fmt.Sprintf(`
+resource "kinde_connection" "entra_id" {
+	name         = %[1]q
+	display_name = "Test Entra ID Connection"
+	strategy     = "wsfed:azure_ad"
+	entra_id_options = {
+		domain             = "example.onmicrosoft.com"
+		client_id          = "test-client-id"
+		client_secret      = "test-client-secret"
+		home_realm_domains = ["example.com"]
+	}
+}
+`, name)
+}
+
+func testAccConnectionResourceConfig_EntraIDWriteOnlySecret(name, secret string, version int) string {
+	return fmt.Sprintf(`
+resource "kinde_connection" "entra_id" {
+	name         = %[1]q
+	display_name = "Test Entra ID Connection"
+	strategy     = "wsfed:azure_ad"
+	entra_id_options = {
+		domain                   = "example.onmicrosoft.com"
+		client_id                = "test-client-id"
+		client_secret_wo         = %[2]q
+		client_secret_wo_version = %[3]d
+	}
+}
+`, name, secret, version)
+}
+
+func testAccConnectionResourceConfig_OIDC(name string) string {
+	return fmt.Sprintf(`
+resource "kinde_connection" "oidc" {
+	name         = %[1]q
+	display_name = "Test OIDC Connection"
+	strategy     = "oidc:custom"
+	oidc_options = {
+		client_id     = "test-client-id"
+		client_secret = "test-client-secret"
+		issuer_url    = "https://idp.example.com"
+		scopes        = ["openid", "profile", "email"]
+		claim_mappings = {
+			email = "mail"
+		}
+	}
+}
+`, name)
+}
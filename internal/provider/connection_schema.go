@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/connections"
+)
+
+// strategyOIDCCustom is the strategy of custom OpenID Connect connections. The
+// connections client does not define it yet.
+const strategyOIDCCustom connections.Strategy = "oidc:custom"
+
+// oidcClaimMappingKeys are the user attributes a custom OIDC connection can
+// map provider claims onto.
+var oidcClaimMappingKeys = []string{"email", "family_name", "given_name", "picture", "username"}
+
+// flattenConnectionResource sets the attributes of the model that are returned
+// by the connections client. The API does not return the options, so they are
+// kept from the model.
+func flattenConnectionResource(conn *connections.Connection, model *ConnectionResourceModel) {
+	model.ID = types.StringValue(conn.ID)
+	model.Name = types.StringValue(conn.Name)
+	model.DisplayName = types.StringValue(conn.DisplayName)
+	model.Strategy = types.StringValue(conn.Strategy)
+}
+
+// ConnectionSAMLOptionsModel represents the options of a saml:custom connection.
+type ConnectionSAMLOptionsModel struct {
+	EntityID           types.String `tfsdk:"entity_id"`
+	SignInURL          types.String `tfsdk:"sign_in_url"`
+	IdpMetadataURL     types.String `tfsdk:"idp_metadata_url"`
+	AcsURL             types.String `tfsdk:"acs_url"`
+	SigningCertificate types.String `tfsdk:"signing_certificate"`
+	SigningPrivateKey  types.String `tfsdk:"signing_private_key"`
+	EmailKeyAttr       types.String `tfsdk:"email_key_attribute"`
+	FirstNameKeyAttr   types.String `tfsdk:"first_name_key_attribute"`
+	LastNameKeyAttr    types.String `tfsdk:"last_name_key_attribute"`
+	HomeRealmDomains   types.List   `tfsdk:"home_realm_domains"`
+	CreateMissingUser  types.Bool   `tfsdk:"create_missing_user"`
+}
+
+// samlConnectionOptions extends the client options with the sign-in URL,
+// which the connections client does not model.
+type samlConnectionOptions struct {
+	connections.SAMLConnectionOptions
+	SAMLSignInURL string `json:"saml_sign_in_url,omitempty"`
+}
+
+// Validate checks the SAML options for the fields Kinde needs to reach the IdP.
+func (m *ConnectionSAMLOptionsModel) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.EntityID.IsNull() {
+		return fmt.Errorf("entity_id must be set for SAML connections")
+	}
+
+	// Unknown values are resolved at apply time
+	if m.IdpMetadataURL.IsUnknown() || m.SignInURL.IsUnknown() {
+		return nil
+	}
+
+	if m.IdpMetadataURL.IsNull() && m.SignInURL.IsNull() {
+		return fmt.Errorf("either idp_metadata_url or sign_in_url must be set for SAML connections")
+	}
+
+	if !m.SigningPrivateKey.IsNull() && m.SigningCertificate.IsNull() {
+		return fmt.Errorf("signing_certificate must be set when signing_private_key is provided")
+	}
+
+	return nil
+}
+
+// ToAPIOptions converts the model to API options.
+func (m *ConnectionSAMLOptionsModel) ToAPIOptions(ctx context.Context) (samlConnectionOptions, error) {
+	opts := samlConnectionOptions{
+		SAMLConnectionOptions: connections.SAMLConnectionOptions{
+			SAMLEntityID:          m.EntityID.ValueString(),
+			SAMLASSURL:            m.AcsURL.ValueString(),
+			SAMLIdpMetadataURL:    m.IdpMetadataURL.ValueString(),
+			SAMLEmailKeyAttr:      m.EmailKeyAttr.ValueString(),
+			SAMLFirstNameKeyAttr:  m.FirstNameKeyAttr.ValueString(),
+			SAMLLastNameKeyAttr:   m.LastNameKeyAttr.ValueString(),
+			IsCreateMissingUser:   m.CreateMissingUser.ValueBool(),
+			SAMLSigningCert:       m.SigningCertificate.ValueString(),
+			SAMLSigningPrivateKey: m.SigningPrivateKey.ValueString(),
+		},
+		SAMLSignInURL: m.SignInURL.ValueString(),
+	}
+
+	if !m.HomeRealmDomains.IsNull() {
+		if diags := m.HomeRealmDomains.ElementsAs(ctx, &opts.HomeRealmDomains, false); diags.HasError() {
+			return opts, fmt.Errorf("could not read home_realm_domains")
+		}
+	}
+
+	return opts, nil
+}
+
+// ConnectionEntraIDOptionsModel represents the options of a wsfed:azure_ad
+// (Microsoft Entra ID enterprise) connection.
+type ConnectionEntraIDOptionsModel struct {
+	Domain                     types.String `tfsdk:"domain"`
+	UseCommonEndpoint          types.Bool   `tfsdk:"use_common_endpoint"`
+	ClientID                   types.String `tfsdk:"client_id"`
+	ClientSecret               types.String `tfsdk:"client_secret"`
+	ClientSecretWO             types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion      types.Int64  `tfsdk:"client_secret_wo_version"`
+	HomeRealmDomains           types.List   `tfsdk:"home_realm_domains"`
+	SyncUserProfileOnLogin     types.Bool   `tfsdk:"sync_user_profile_on_login"`
+	RetrieveProviderUserGroups types.Bool   `tfsdk:"retrieve_provider_user_groups"`
+	ExtendedAttributesRequired types.Bool   `tfsdk:"extended_attributes_required"`
+}
+
+// Validate checks the Entra ID options for the client credentials and tenant.
+func (m *ConnectionEntraIDOptionsModel) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !m.ClientSecret.IsNull() && !m.ClientSecretWO.IsNull() {
+		return fmt.Errorf("only one of client_secret and client_secret_wo can be set")
+	}
+
+	if !m.ClientSecretWOVersion.IsNull() && m.ClientSecretWO.IsNull() {
+		return fmt.Errorf("client_secret_wo_version can only be set together with client_secret_wo")
+	}
+
+	if m.ClientID.IsNull() || (m.ClientSecret.IsNull() && m.ClientSecretWO.IsNull()) {
+		return fmt.Errorf("both client_id and client_secret (or client_secret_wo) must be set for Entra ID connections")
+	}
+
+	// The tenant domain is only optional when signing in through the common endpoint
+	if m.Domain.IsNull() && !m.UseCommonEndpoint.ValueBool() {
+		return fmt.Errorf("domain must be set for Entra ID connections unless use_common_endpoint is true")
+	}
+
+	return nil
+}
+
+// ToAPIOptions converts the model to API options.
+func (m *ConnectionEntraIDOptionsModel) ToAPIOptions(ctx context.Context) (connections.AzureADConnectionOptions, error) {
+	opts := connections.AzureADConnectionOptions{
+		ClientID:                     m.ClientID.ValueString(),
+		ClientSecret:                 m.ClientSecret.ValueString(),
+		EntraIDDomain:                m.Domain.ValueString(),
+		IsUseCommonEndpoint:          m.UseCommonEndpoint.ValueBool(),
+		IsSyncUserProfileOnLogin:     m.SyncUserProfileOnLogin.ValueBool(),
+		IsRetrieveProviderUserGroups: m.RetrieveProviderUserGroups.ValueBool(),
+		IsExtendedAttributesRequired: m.ExtendedAttributesRequired.ValueBool(),
+	}
+
+	// The write-only secret is only ever present when read from config
+	if !m.ClientSecretWO.IsNull() {
+		opts.ClientSecret = m.ClientSecretWO.ValueString()
+	}
+
+	if !m.HomeRealmDomains.IsNull() {
+		if diags := m.HomeRealmDomains.ElementsAs(ctx, &opts.HomeRealmDomains, false); diags.HasError() {
+			return opts, fmt.Errorf("could not read home_realm_domains")
+		}
+	}
+
+	return opts, nil
+}
+
+// ConnectionOIDCOptionsModel represents the options of a custom OpenID Connect
+// connection.
+type ConnectionOIDCOptionsModel struct {
+	ClientID              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	IssuerURL             types.String `tfsdk:"issuer_url"`
+	DiscoveryURL          types.String `tfsdk:"discovery_url"`
+	AuthorizationEndpoint types.String `tfsdk:"authorization_endpoint"`
+	TokenEndpoint         types.String `tfsdk:"token_endpoint"`
+	UserinfoEndpoint      types.String `tfsdk:"userinfo_endpoint"`
+	Scopes                types.List   `tfsdk:"scopes"`
+	ClaimMappings         types.Map    `tfsdk:"claim_mappings"`
+	HomeRealmDomains      types.List   `tfsdk:"home_realm_domains"`
+}
+
+// oidcConnectionOptions is the API representation of custom OIDC options.
+type oidcConnectionOptions struct {
+	ClientID              string            `json:"client_id"`
+	ClientSecret          string            `json:"client_secret"`
+	IssuerURL             string            `json:"issuer_url,omitempty"`
+	DiscoveryURL          string            `json:"discovery_url,omitempty"`
+	AuthorizationEndpoint string            `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint         string            `json:"token_endpoint,omitempty"`
+	UserinfoEndpoint      string            `json:"userinfo_endpoint,omitempty"`
+	Scopes                []string          `json:"scopes,omitempty"`
+	ClaimMappings         map[string]string `json:"claim_mappings,omitempty"`
+	HomeRealmDomains      []string          `json:"home_realm_domains,omitempty"`
+}
+
+// Validate checks that the endpoints of the identity provider can be resolved,
+// either through discovery or because they are all configured explicitly.
+func (m *ConnectionOIDCOptionsModel) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.ClientID.IsNull() || m.ClientSecret.IsNull() {
+		return fmt.Errorf("both client_id and client_secret must be set for OIDC connections")
+	}
+
+	endpoints := []struct {
+		name  string
+		value types.String
+	}{
+		{"issuer_url", m.IssuerURL},
+		{"discovery_url", m.DiscoveryURL},
+		{"authorization_endpoint", m.AuthorizationEndpoint},
+		{"token_endpoint", m.TokenEndpoint},
+		{"userinfo_endpoint", m.UserinfoEndpoint},
+	}
+	for _, endpoint := range endpoints {
+		if err := validateHTTPSURL(endpoint.name, endpoint.value); err != nil {
+			return err
+		}
+	}
+
+	hasDiscovery := !m.IssuerURL.IsNull() || !m.DiscoveryURL.IsNull()
+	hasEndpoints := !m.AuthorizationEndpoint.IsNull() && !m.TokenEndpoint.IsNull()
+	if !hasDiscovery && !hasEndpoints {
+		return fmt.Errorf("either issuer_url, discovery_url or both authorization_endpoint and token_endpoint must be set for OIDC connections")
+	}
+
+	if !m.ClaimMappings.IsNull() && !m.ClaimMappings.IsUnknown() {
+		for key := range m.ClaimMappings.Elements() {
+			if !slices.Contains(oidcClaimMappingKeys, key) {
+				return fmt.Errorf("unsupported claim mapping %q, expected one of: %s", key, strings.Join(oidcClaimMappingKeys, ", "))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ToAPIOptions converts the model to API options.
+func (m *ConnectionOIDCOptionsModel) ToAPIOptions(ctx context.Context) (oidcConnectionOptions, error) {
+	opts := oidcConnectionOptions{
+		ClientID:              m.ClientID.ValueString(),
+		ClientSecret:          m.ClientSecret.ValueString(),
+		IssuerURL:             m.IssuerURL.ValueString(),
+		DiscoveryURL:          m.DiscoveryURL.ValueString(),
+		AuthorizationEndpoint: m.AuthorizationEndpoint.ValueString(),
+		TokenEndpoint:         m.TokenEndpoint.ValueString(),
+		UserinfoEndpoint:      m.UserinfoEndpoint.ValueString(),
+	}
+
+	if !m.Scopes.IsNull() {
+		if diags := m.Scopes.ElementsAs(ctx, &opts.Scopes, false); diags.HasError() {
+			return opts, fmt.Errorf("could not read scopes")
+		}
+	}
+
+	if !m.ClaimMappings.IsNull() {
+		if diags := m.ClaimMappings.ElementsAs(ctx, &opts.ClaimMappings, false); diags.HasError() {
+			return opts, fmt.Errorf("could not read claim_mappings")
+		}
+	}
+
+	if !m.HomeRealmDomains.IsNull() {
+		if diags := m.HomeRealmDomains.ElementsAs(ctx, &opts.HomeRealmDomains, false); diags.HasError() {
+			return opts, fmt.Errorf("could not read home_realm_domains")
+		}
+	}
+
+	return opts, nil
+}
+
+// validateHTTPSURL returns an error if a known, non-null value is not an
+// absolute https URL.
+func validateHTTPSURL(name string, value types.String) error {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	u, err := url.Parse(value.ValueString())
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("%s must be an absolute https URL, got %q", name, value.ValueString())
+	}
+
+	return nil
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/nxt-fwd/kinde-go"
 	"github.com/nxt-fwd/kinde-go/api/connections"
 )
 
@@ -19,6 +18,7 @@ func NewConnectionsDataSource() datasource.DataSource {
 
 type ConnectionsDataSource struct {
 	client *connections.Client
+	cache  *providerCache
 }
 
 type ConnectionsDataSourceModel struct {
@@ -74,16 +74,17 @@ func (d *ConnectionsDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*kinde.Client)
+	client, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *kinde.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	d.client = client.Connections
+	d.cache = client.cache
 }
 
 func isBuiltinStrategy(strategy string) bool {
@@ -108,7 +109,7 @@ func (d *ConnectionsDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// Get all connections
-	conns, err := d.client.List(ctx)
+	conns, err := d.cache.Connections(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read connections, got error: %s", err))
 		return
@@ -0,0 +1,549 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go"
+	"github.com/nxt-fwd/kinde-go/api/applications"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ExportOptions configures the export of a Kinde environment. Credentials
+// that are not set fall back to the KINDE_* environment variables, like in the
+// provider configuration.
+type ExportOptions struct {
+	Domain       string
+	Audience     string
+	ClientID     string
+	ClientSecret string
+
+	// OutputDir is the directory the generated files are written to.
+	OutputDir string
+}
+
+// Export writes the configuration of every supported object of a Kinde
+// environment to .tf files, each resource followed by its import block.
+// References between roles, permissions, users, organization memberships and
+// application and organization connections are written as resource addresses.
+func Export(ctx context.Context, opts ExportOptions) error {
+	client, err := newClient(ctx, clientOptions(KindeProviderModel{
+		Domain:       exportCredential(opts.Domain),
+		Audience:     exportCredential(opts.Audience),
+		ClientID:     exportCredential(opts.ClientID),
+		ClientSecret: exportCredential(opts.ClientSecret),
+	}))
+	if err != nil {
+		return fmt.Errorf("could not authenticate with Kinde API: %w", err)
+	}
+
+	e := &exporter{client: &client, cache: newProviderCache(&client)}
+
+	// Referenced objects are exported first, so their addresses are known
+	steps := []struct {
+		file   string
+		export func(context.Context, *hclwrite.Body) error
+	}{
+		{"permissions.tf", e.exportPermissions},
+		{"roles.tf", e.exportRoles},
+		{"users.tf", e.exportUsers},
+		{"organizations.tf", e.exportOrganizations},
+		{"organization_users.tf", e.exportOrganizationUsers},
+		{"applications.tf", e.exportApplications},
+		{"apis.tf", e.exportAPIs},
+		{"connections.tf", e.exportConnections},
+		{"application_connections.tf", e.exportApplicationConnections},
+		{"organization_connections.tf", e.exportOrganizationConnections},
+	}
+
+	// Existing configuration is never overwritten
+	for _, step := range steps {
+		if _, err := os.Stat(filepath.Join(opts.OutputDir, step.file)); err == nil {
+			return fmt.Errorf("%s already exists in %s, export to an empty directory instead", step.file, opts.OutputDir)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(opts.OutputDir, 0o755); err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		file := hclwrite.NewEmptyFile()
+		if err := step.export(ctx, file.Body()); err != nil {
+			return fmt.Errorf("could not export %s: %w", step.file, err)
+		}
+
+		if len(file.Body().Blocks()) == 0 {
+			continue
+		}
+
+		if err := os.WriteFile(filepath.Join(opts.OutputDir, step.file), file.Bytes(), 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func exportCredential(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// exportNameInvalidChars matches the characters that are not allowed in
+// resource names.
+var exportNameInvalidChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// exporter writes the configuration of Kinde objects and remembers the
+// resource name of every exported object, so later objects can reference it.
+type exporter struct {
+	client *kinde.Client
+	cache  *providerCache
+
+	// names maps resource type and object ID to the resource name
+	names map[string]map[string]string
+	// usedNames holds the resource names taken per resource type
+	usedNames map[string]map[string]bool
+
+	organizations []organizations.Organization
+	applications  []applications.Application
+}
+
+// addResource appends a resource block and its import block to the body and
+// returns the body of the resource block. The resource name is derived from
+// name, which should be human-readable, e.g. a key or an email.
+func (e *exporter) addResource(body *hclwrite.Body, resourceType, id, name, importID string) *hclwrite.Body {
+	resourceName := e.resourceName(resourceType, id, name)
+
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	resource := body.AppendNewBlock("resource", []string{resourceType, resourceName})
+	body.AppendNewline()
+
+	importBlock := body.AppendNewBlock("import", nil)
+	importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: resourceName},
+	})
+	importBlock.Body().SetAttributeValue("id", cty.StringVal(importID))
+
+	return resource.Body()
+}
+
+// resourceName returns a unique resource name for an object and records it.
+func (e *exporter) resourceName(resourceType, id, name string) string {
+	if e.names == nil {
+		e.names = make(map[string]map[string]string)
+		e.usedNames = make(map[string]map[string]bool)
+	}
+	if e.names[resourceType] == nil {
+		e.names[resourceType] = make(map[string]string)
+		e.usedNames[resourceType] = make(map[string]bool)
+	}
+
+	base := strings.Trim(exportNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+	if base == "" {
+		base = "unnamed"
+	}
+	// Names have to start with a letter or an underscore
+	if base[0] < 'a' || base[0] > 'z' {
+		base = "_" + base
+	}
+
+	resourceName := base
+	for i := 2; e.usedNames[resourceType][resourceName]; i++ {
+		resourceName = fmt.Sprintf("%s_%d", base, i)
+	}
+
+	e.usedNames[resourceType][resourceName] = true
+	e.names[resourceType][id] = resourceName
+	return resourceName
+}
+
+// reference returns an expression for the attribute of an exported object.
+// Objects that were not exported are referenced by their ID.
+func (e *exporter) reference(resourceType, id, attribute string) hclwrite.Tokens {
+	resourceName, ok := e.names[resourceType][id]
+	if !ok {
+		return hclwrite.TokensForValue(cty.StringVal(id))
+	}
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: resourceName},
+		hcl.TraverseAttr{Name: attribute},
+	})
+}
+
+// references returns a list expression of the references to the attribute of
+// exported objects.
+func (e *exporter) references(resourceType string, ids []string, attribute string) hclwrite.Tokens {
+	elems := make([]hclwrite.Tokens, 0, len(ids))
+	for _, id := range sortStringSlice(ids) {
+		elems = append(elems, e.reference(resourceType, id, attribute))
+	}
+	return hclwrite.TokensForTuple(elems)
+}
+
+// setOptionalString sets the attribute unless the value is empty.
+func setOptionalString(body *hclwrite.Body, name, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
+
+// appendComment appends a comment line to the body.
+func appendComment(body *hclwrite.Body, comment string) {
+	body.AppendUnstructuredTokens(hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte("# " + comment + "\n")},
+	})
+}
+
+func (e *exporter) exportPermissions(ctx context.Context, body *hclwrite.Body) error {
+	perms, err := collect(listAllPermissions(ctx, e.client.Permissions))
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(perms, func(i, j int) bool { return perms[i].Key < perms[j].Key })
+	for _, permission := range perms {
+		resource := e.addResource(body, "kinde_permission", permission.ID, permission.Key, permission.ID)
+		resource.SetAttributeValue("name", cty.StringVal(permission.Name))
+		resource.SetAttributeValue("key", cty.StringVal(permission.Key))
+		setOptionalString(resource, "description", permission.Description)
+	}
+
+	return nil
+}
+
+func (e *exporter) exportRoles(ctx context.Context, body *hclwrite.Body) error {
+	cached, err := e.cache.Roles(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The cached roles are shared with the organization users
+	allRoles := slices.Clone(cached)
+	sort.Slice(allRoles, func(i, j int) bool { return allRoles[i].Key < allRoles[j].Key })
+	for _, role := range allRoles {
+		perms, err := e.client.Roles.GetRolePermissions(ctx, role.ID)
+		if err != nil {
+			return fmt.Errorf("could not read permissions of role %s: %w", role.Key, err)
+		}
+
+		resource := e.addResource(body, "kinde_role", role.ID, role.Key, role.ID)
+		resource.SetAttributeValue("name", cty.StringVal(role.Name))
+		resource.SetAttributeValue("key", cty.StringVal(role.Key))
+		setOptionalString(resource, "description", role.Description)
+		if len(perms) > 0 {
+			resource.SetAttributeRaw("permissions", e.references("kinde_permission", perms, "id"))
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) exportUsers(ctx context.Context, body *hclwrite.Body) error {
+	allUsers, err := collect(listAllUsers(ctx, e.client.Users))
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(allUsers, func(i, j int) bool {
+		if allUsers[i].PreferredEmail == allUsers[j].PreferredEmail {
+			return allUsers[i].ID < allUsers[j].ID
+		}
+		return allUsers[i].PreferredEmail < allUsers[j].PreferredEmail
+	})
+	for _, user := range allUsers {
+		identities, err := e.client.Users.GetIdentities(ctx, user.ID)
+		if err != nil {
+			return fmt.Errorf("could not read identities of user %s: %w", user.ID, err)
+		}
+
+		// Identities created by sign-ins through a connection are not managed
+		var values []cty.Value
+		for _, identity := range identities {
+			if !isManagedIdentity(identity.Type, types.BoolNull()) {
+				continue
+			}
+			values = append(values, cty.ObjectVal(map[string]cty.Value{
+				"type":  cty.StringVal(identity.Type),
+				"value": cty.StringVal(identity.Name),
+			}))
+		}
+		sort.Slice(values, func(i, j int) bool {
+			typeI, typeJ := values[i].GetAttr("type").AsString(), values[j].GetAttr("type").AsString()
+			if typeI == typeJ {
+				return values[i].GetAttr("value").AsString() < values[j].GetAttr("value").AsString()
+			}
+			return typeI < typeJ
+		})
+
+		name := user.PreferredEmail
+		if name == "" {
+			name = user.ID
+		}
+
+		resource := e.addResource(body, "kinde_user", user.ID, name, user.ID)
+		setOptionalString(resource, "first_name", user.FirstName)
+		setOptionalString(resource, "last_name", user.LastName)
+		if user.IsSuspended {
+			resource.SetAttributeValue("is_suspended", cty.True)
+		}
+		// identities is required, users with only OAuth2 identities get an empty set
+		if len(values) > 0 {
+			resource.SetAttributeValue("identities", cty.TupleVal(values))
+		} else {
+			resource.SetAttributeValue("identities", cty.EmptyTupleVal)
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) exportOrganizations(ctx context.Context, body *hclwrite.Body) error {
+	orgs, err := collect(listAllOrganizations(ctx, e.client.Organizations))
+	if err != nil {
+		return err
+	}
+
+	orgResource := &OrganizationResource{client: e.client.Organizations}
+
+	sort.Slice(orgs, func(i, j int) bool { return orgs[i].Code < orgs[j].Code })
+	for _, listed := range orgs {
+		// The organization list does not include branding or policy
+		org, policy, err := orgResource.getOrganization(ctx, listed.Code)
+		if err != nil {
+			return fmt.Errorf("could not read organization %s: %w", listed.Code, err)
+		}
+
+		name := org.Name
+		if name == "" {
+			name = org.Code
+		}
+
+		resource := e.addResource(body, "kinde_organization", org.Code, name, org.Code)
+		resource.SetAttributeValue("name", cty.StringVal(org.Name))
+		resource.SetAttributeValue("code", cty.StringVal(org.Code))
+		if org.ExternalID != nil {
+			setOptionalString(resource, "external_id", *org.ExternalID)
+		}
+		if org.Handle != nil {
+			setOptionalString(resource, "handle", *org.Handle)
+		}
+
+		setOptionalString(resource, "theme_code", org.ColorScheme)
+		colors := []struct {
+			name  string
+			color *organizations.Color
+		}{
+			{"background_color", org.BackgroundColor},
+			{"button_color", org.ButtonColor},
+			{"button_text_color", org.ButtonTextColor},
+			{"link_color", org.LinkColor},
+			{"background_color_dark", org.BackgroundColorDark},
+			{"button_color_dark", org.ButtonColorDark},
+			{"button_text_color_dark", org.ButtonTextColorDark},
+			{"link_color_dark", org.LinkColorDark},
+		}
+		for _, color := range colors {
+			if color.color != nil {
+				setOptionalString(resource, color.name, color.color.Hex)
+			}
+		}
+
+		if policy.IsAllowRegistrations != nil && *policy.IsAllowRegistrations {
+			resource.SetAttributeValue("allow_registrations", cty.True)
+		}
+		if policy.IsAutoMembershipEnabled != nil && *policy.IsAutoMembershipEnabled {
+			resource.SetAttributeValue("auto_membership_enabled", cty.True)
+		}
+		if len(policy.AllowedDomains) > 0 {
+			domains := make([]cty.Value, 0, len(policy.AllowedDomains))
+			for _, domain := range sortStringSlice(policy.AllowedDomains) {
+				domains = append(domains, cty.StringVal(domain))
+			}
+			resource.SetAttributeValue("allowed_domains", cty.TupleVal(domains))
+		}
+	}
+
+	e.organizations = orgs
+	return nil
+}
+
+func (e *exporter) exportOrganizationUsers(ctx context.Context, body *hclwrite.Body) error {
+	for _, org := range e.organizations {
+		endpoint := fmt.Sprintf("/api/v1/organizations/%s/users", org.Code)
+		members, err := collect(paginate[organizationMember](ctx, e.client.Organizations, endpoint, "organization_users", listPageSize))
+		if err != nil {
+			return fmt.Errorf("could not list users of organization %s: %w", org.Code, err)
+		}
+
+		memberRoles, err := memberRoleIDs(ctx, e.cache, members)
+		if err != nil {
+			return fmt.Errorf("could not read roles of organization %s: %w", org.Code, err)
+		}
+
+		sort.Slice(members, func(i, j int) bool { return members[i].ID < members[j].ID })
+		for _, member := range members {
+			roleIDs := memberRoles[member.ID]
+
+			id := fmt.Sprintf("%s:%s", org.Code, member.ID)
+			name := fmt.Sprintf("%s_%s", e.nameOf("kinde_organization", org.Code), e.nameOf("kinde_user", member.ID))
+
+			resource := e.addResource(body, "kinde_organization_user", id, name, id)
+			resource.SetAttributeRaw("organization_code", e.reference("kinde_organization", org.Code, "code"))
+			resource.SetAttributeRaw("user_id", e.reference("kinde_user", member.ID, "id"))
+			if len(roleIDs) > 0 {
+				resource.SetAttributeRaw("roles", e.references("kinde_role", roleIDs, "id"))
+			}
+		}
+	}
+
+	return nil
+}
+
+// nameOf returns the resource name of an exported object, or its ID if the
+// object was not exported.
+func (e *exporter) nameOf(resourceType, id string) string {
+	if resourceName, ok := e.names[resourceType][id]; ok {
+		return resourceName
+	}
+	return id
+}
+
+func (e *exporter) exportApplications(ctx context.Context, body *hclwrite.Body) error {
+	apps, err := collect(paginate[applications.Application](ctx, e.client.Applications, "/api/v1/applications", "applications", listPageSize))
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+	for _, application := range apps {
+		// The application list does not include URIs
+		app, err := e.client.Applications.Get(ctx, application.ID)
+		if err != nil {
+			return fmt.Errorf("could not read application %s: %w", application.ID, err)
+		}
+
+		resource := e.addResource(body, "kinde_application", app.ID, app.Name, app.ID)
+		resource.SetAttributeValue("name", cty.StringVal(app.Name))
+		resource.SetAttributeValue("type", cty.StringVal(string(app.Type)))
+		setOptionalString(resource, "login_uri", app.LoginURI)
+		setOptionalString(resource, "homepage_uri", app.HomepageURI)
+	}
+
+	e.applications = apps
+	return nil
+}
+
+func (e *exporter) exportAPIs(ctx context.Context, body *hclwrite.Body) error {
+	allAPIs, err := collect(listAllAPIs(ctx, e.client.APIs))
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(allAPIs, func(i, j int) bool { return allAPIs[i].Audience < allAPIs[j].Audience })
+	for _, api := range allAPIs {
+		// The management API exists in every environment and cannot be deleted
+		if api.IsManagementAPI {
+			continue
+		}
+
+		resource := e.addResource(body, "kinde_api", api.ID, api.Name, api.ID)
+		resource.SetAttributeValue("name", cty.StringVal(api.Name))
+		resource.SetAttributeValue("audience", cty.StringVal(api.Audience))
+	}
+
+	return nil
+}
+
+func (e *exporter) exportConnections(ctx context.Context, body *hclwrite.Body) error {
+	// The connections client does not expose its requests
+	conns, err := collect(listAllConnections(ctx, e.client.Organizations))
+	if err != nil {
+		return err
+	}
+
+	if len(conns) > 0 {
+		appendComment(body, "Connection options are not returned by the API and have to be added before the first apply.")
+		body.AppendNewline()
+	}
+
+	sort.Slice(conns, func(i, j int) bool { return conns[i].Name < conns[j].Name })
+	for _, conn := range conns {
+		resource := e.addResource(body, "kinde_connection", conn.ID, conn.Name, conn.ID)
+		resource.SetAttributeValue("name", cty.StringVal(conn.Name))
+		resource.SetAttributeValue("display_name", cty.StringVal(conn.DisplayName))
+		resource.SetAttributeValue("strategy", cty.StringVal(conn.Strategy))
+	}
+
+	return nil
+}
+
+func (e *exporter) exportApplicationConnections(ctx context.Context, body *hclwrite.Body) error {
+	for _, app := range e.applications {
+		conns, err := e.client.Applications.GetConnections(ctx, app.ID)
+		if err != nil {
+			return fmt.Errorf("could not read connections of application %s: %w", app.ID, err)
+		}
+
+		sort.Slice(conns, func(i, j int) bool { return conns[i].ID < conns[j].ID })
+		for _, conn := range conns {
+			id := fmt.Sprintf("%s:%s", app.ID, conn.ID)
+			name := fmt.Sprintf("%s_%s", e.nameOf("kinde_application", app.ID), e.nameOf("kinde_connection", conn.ID))
+
+			resource := e.addResource(body, "kinde_application_connection", id, name, id)
+			resource.SetAttributeRaw("application_id", e.reference("kinde_application", app.ID, "id"))
+			resource.SetAttributeRaw("connection_id", e.reference("kinde_connection", conn.ID, "id"))
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) exportOrganizationConnections(ctx context.Context, body *hclwrite.Body) error {
+	orgResource := &OrganizationConnectionResource{client: e.client.Organizations}
+
+	for _, org := range e.organizations {
+		conns, err := orgResource.getConnections(ctx, org.Code)
+		if err != nil {
+			return fmt.Errorf("could not read connections of organization %s: %w", org.Code, err)
+		}
+
+		sort.Slice(conns, func(i, j int) bool { return conns[i].ID < conns[j].ID })
+		for _, conn := range conns {
+			id := fmt.Sprintf("%s:%s", org.Code, conn.ID)
+			name := fmt.Sprintf("%s_%s", e.nameOf("kinde_organization", org.Code), e.nameOf("kinde_connection", conn.ID))
+
+			resource := e.addResource(body, "kinde_organization_connection", id, name, id)
+			resource.SetAttributeRaw("organization_code", e.reference("kinde_organization", org.Code, "code"))
+			resource.SetAttributeRaw("connection_id", e.reference("kinde_connection", conn.ID, "id"))
+		}
+	}
+
+	return nil
+}
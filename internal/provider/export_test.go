@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExport_ResolvesReferences(t *testing.T) {
+	responses := map[string]string{
+		"/api/v1/permissions":                        `{"permissions":[{"id":"perm_read","key":"read","name":"Read"}]}`,
+		"/api/v1/roles":                              `{"roles":[{"id":"role_admin","key":"admin","name":"Admin"}]}`,
+		"/api/v1/roles/role_admin/permissions":       `{"permissions":[{"id":"perm_read","key":"read"},{"id":"perm_unmanaged","key":"other"}]}`,
+		"/api/v1/users":                              `{"users":[{"id":"kp_alice","preferred_email":"alice@example.com","first_name":"Alice"},{"id":"kp_bob","preferred_email":"bob@gmail.com"}]}`,
+		"/api/v1/users/kp_alice/identities":          `{"identities":[{"type":"email","name":"alice@example.com"},{"type":"oauth2:google","name":"alice@gmail.com"}]}`,
+		"/api/v1/users/kp_bob/identities":            `{"identities":[{"type":"oauth2:google","name":"bob@gmail.com"},{"type":"enterprise","name":"bob@corp.example.com"},{"type":"social","name":"bob"}]}`,
+		"/api/v1/organizations":                      `{"organizations":[{"code":"org_acme","name":"Acme Corp"}]}`,
+		"/api/v1/organization":                       `{"code":"org_acme","name":"Acme Corp","color_scheme":"dark","button_color":{"hex":"#0055ff"},"is_allow_registrations":true,"is_auto_membership_enabled":true,"allowed_domains":["example.com"]}`,
+		"/api/v1/organizations/org_acme/users":       `{"organization_users":[{"id":"kp_alice","email":"alice@example.com","roles":["admin"]}]}`,
+		"/api/v1/organizations/org_acme/connections": `{"connections":[{"id":"conn_google","name":"google"}]}`,
+		"/api/v1/applications":                       `{"applications":[{"id":"app_web","name":"Web","type":"reg"}]}`,
+		"/api/v1/applications/app_web":               `{"application":{"id":"app_web","name":"Web","type":"reg"}}`,
+		"/api/v1/applications/app_web/connections":   `{"connections":[{"id":"conn_google","name":"google"}]}`,
+		"/api/v1/apis":                               `{"apis":[{"id":"api_management","name":"Kinde Management API","audience":"https://example.kinde.com/api","is_management_api":true}]}`,
+		"/api/v1/connections":                        `{"connections":[{"id":"conn_google","name":"google","display_name":"Google","strategy":"oauth2:google"}],"has_more":false}`,
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if r.Method != http.MethodGet || !ok {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(response))
+	})
+
+	dir := t.TempDir()
+	err := Export(context.Background(), ExportOptions{
+		Domain:       server.URL,
+		Audience:     server.URL + "/api",
+		ClientID:     "test",
+		ClientSecret: "test",
+		OutputDir:    dir,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read := func(name string) string {
+		t.Helper()
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("could not read %s: %s", name, err)
+		}
+		return string(content)
+	}
+
+	expectations := map[string][]string{
+		"permissions.tf": {
+			`resource "kinde_permission" "read" {`,
+			`to = kinde_permission.read`,
+			`id = "perm_read"`,
+		},
+		"roles.tf": {
+			`resource "kinde_role" "admin" {`,
+			`permissions = [kinde_permission.read.id, "perm_unmanaged"]`,
+		},
+		"users.tf": {
+			`resource "kinde_user" "alice_example_com" {`,
+			`first_name = "Alice"`,
+			`value = "alice@example.com"`,
+			`resource "kinde_user" "bob_gmail_com" {`,
+			`identities = []`,
+		},
+		"organizations.tf": {
+			`resource "kinde_organization" "acme_corp" {`,
+			`theme_code              = "dark"`,
+			`button_color            = "#0055ff"`,
+			`allow_registrations     = true`,
+			`auto_membership_enabled = true`,
+			`allowed_domains         = ["example.com"]`,
+			`id = "org_acme"`,
+		},
+		"organization_users.tf": {
+			`resource "kinde_organization_user" "acme_corp_alice_example_com" {`,
+			`organization_code = kinde_organization.acme_corp.code`,
+			`user_id           = kinde_user.alice_example_com.id`,
+			`roles             = [kinde_role.admin.id]`,
+			`id = "org_acme:kp_alice"`,
+		},
+		"application_connections.tf": {
+			`resource "kinde_application_connection" "web_google" {`,
+			`application_id = kinde_application.web.id`,
+			`connection_id  = kinde_connection.google.id`,
+			`id = "app_web:conn_google"`,
+		},
+		"organization_connections.tf": {
+			`resource "kinde_organization_connection" "acme_corp_google" {`,
+			`organization_code = kinde_organization.acme_corp.code`,
+			`connection_id     = kinde_connection.google.id`,
+			`id = "org_acme:conn_google"`,
+		},
+	}
+	for name, wants := range expectations {
+		content := read(name)
+		for _, want := range wants {
+			if !strings.Contains(content, want) {
+				t.Errorf("expected %s to contain %q, got:\n%s", name, want, content)
+			}
+		}
+	}
+
+	// Identities created by sign-ins are not managed
+	if content := read("users.tf"); strings.Contains(content, "oauth2") || strings.Contains(content, "enterprise") || strings.Contains(content, "social") {
+		t.Errorf("expected connection identities not to be exported, got:\n%s", content)
+	}
+
+	// Object types without exported objects do not get a file
+	if _, err := os.Stat(filepath.Join(dir, "apis.tf")); !os.IsNotExist(err) {
+		t.Errorf("expected no apis.tf, got %v", err)
+	}
+
+	// Existing files are not overwritten
+	if err := os.WriteFile(filepath.Join(dir, "roles.tf"), []byte("# edited\n"), 0o644); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = Export(context.Background(), ExportOptions{
+		Domain:       server.URL,
+		Audience:     server.URL + "/api",
+		ClientID:     "test",
+		ClientSecret: "test",
+		OutputDir:    dir,
+	})
+	if err == nil || !strings.Contains(err.Error(), "permissions.tf already exists") {
+		t.Errorf("expected existing files error, got %v", err)
+	}
+	if content := read("roles.tf"); content != "# edited\n" {
+		t.Errorf("expected roles.tf to be kept, got:\n%s", content)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls a provider function with a single string argument.
+func runFunction(t *testing.T, f function.Function, result attr.Value, argument string) function.RunResponse {
+	t.Helper()
+
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(argument)}),
+	}, &resp)
+	return resp
+}
+
+func TestParseIDFunctions(t *testing.T) {
+	resp := runFunction(t, NewParseUserRoleIDFunction(), types.ObjectUnknown(userRoleIDAttrTypes), "org_acme:kp_alice:role_admin")
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	want := types.ObjectValueMust(userRoleIDAttrTypes, map[string]attr.Value{
+		"organization_code": types.StringValue("org_acme"),
+		"user_id":           types.StringValue("kp_alice"),
+		"role_id":           types.StringValue("role_admin"),
+	})
+	if !resp.Result.Value().Equal(want) {
+		t.Errorf("expected %s, got %s", want, resp.Result.Value())
+	}
+
+	resp = runFunction(t, NewParseOrganizationUserIDFunction(), types.ObjectUnknown(organizationUserIDAttrTypes), "org_acme:kp_alice")
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	want = types.ObjectValueMust(organizationUserIDAttrTypes, map[string]attr.Value{
+		"organization_code": types.StringValue("org_acme"),
+		"user_id":           types.StringValue("kp_alice"),
+	})
+	if !resp.Result.Value().Equal(want) {
+		t.Errorf("expected %s, got %s", want, resp.Result.Value())
+	}
+
+	resp = runFunction(t, NewParseOrganizationUserIDFunction(), types.ObjectUnknown(organizationUserIDAttrTypes), "org_acme:kp_alice:role_admin")
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("expected argument error, got %v", resp.Error)
+	}
+}
+
+func TestURLFunctions(t *testing.T) {
+	tests := []struct {
+		function func() function.Function
+		domain   string
+		want     string
+		wantErr  bool
+	}{
+		{function: NewBuildIssuerURLFunction, domain: "https://example.kinde.com/", want: "https://example.kinde.com"},
+		{function: NewBuildIssuerURLFunction, domain: "auth.example.com", want: "https://auth.example.com"},
+		{function: NewBuildAuthorizeURLFunction, domain: "example.kinde.com", want: "https://example.kinde.com/oauth2/auth"},
+		{function: NewBuildJWKSURLFunction, domain: "https://example.kinde.com", want: "https://example.kinde.com/.well-known/jwks.json"},
+		{function: NewBuildJWKSURLFunction, domain: "https://example.kinde.com/api", wantErr: true},
+		{function: NewBuildIssuerURLFunction, domain: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		resp := runFunction(t, tt.function(), types.StringUnknown(), tt.domain)
+		if tt.wantErr {
+			if resp.Error == nil {
+				t.Errorf("%s: expected error, got %s", tt.domain, resp.Result.Value())
+			}
+			continue
+		}
+		if resp.Error != nil {
+			t.Errorf("%s: unexpected error: %s", tt.domain, resp.Error)
+			continue
+		}
+		if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.domain, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listResultFunc sets the display name, identity and, if requested, the
+// resource of the list result of an item.
+type listResultFunc[T any] func(ctx context.Context, item T, result *list.ListResult)
+
+// listResourceConfigSchema returns the schema of list blocks. Listing always
+// returns every instance, so list blocks have no arguments.
+func listResourceConfigSchema(description string) listschema.Schema {
+	return listschema.Schema{
+		MarkdownDescription: description,
+	}
+}
+
+// listResults turns the items of an iterator into list results. Listing stops
+// after the first error and once the limit of the request is reached. The
+// noun names the listed items in error messages, e.g. permissions.
+func listResults[T any](ctx context.Context, req list.ListRequest, noun string, items iter.Seq2[T, error], flatten listResultFunc[T]) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var count int64
+		for item, err := range items {
+			result := req.NewListResult(ctx)
+			if err != nil {
+				result.Diagnostics.AddError(
+					"Error Listing Resources",
+					fmt.Sprintf("Could not list %s: %s", noun, err),
+				)
+				push(result)
+				return
+			}
+
+			flatten(ctx, item, &result)
+			if !push(result) || result.Diagnostics.HasError() {
+				return
+			}
+
+			count++
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+		}
+	}
+}
+
+// setListResult sets the identity of a list result and, if the request
+// includes resources, the resource state returned by read.
+func setListResult[M any](ctx context.Context, req list.ListRequest, result *list.ListResult, id types.String, read func() (M, diag.Diagnostics)) {
+	result.Diagnostics.Append(result.Identity.Set(ctx, resourceIDIdentity(id))...)
+	if !req.IncludeResource {
+		return
+	}
+
+	state, diags := read()
+	result.Diagnostics.Append(diags...)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+}
+
+// sliceItems returns an iterator over the items of a slice, or over the error
+// that occurred while loading them.
+func sliceItems[T any](items []T, err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+
+		for _, item := range items {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestRoleResource_List(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/roles":
+			_, _ = w.Write([]byte(`{"roles":[{"id":"role_admin","key":"admin","name":"Admin","description":"Administrators"},{"id":"role_viewer","key":"viewer","name":"Viewer"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/roles/role_admin/permissions":
+			_, _ = w.Write([]byte(`{"permissions":[{"id":"perm_write","key":"write"},{"id":"perm_read","key":"read"}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	ctx := context.Background()
+
+	r := &RoleResource{}
+	var configureResp resource.ConfigureResponse
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: newProviderData(client)}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure error: %v", configureResp.Diagnostics)
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	req := list.ListRequest{
+		IncludeResource:        true,
+		Limit:                  1,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+
+	var stream list.ListResultsStream
+	r.List(ctx, req, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+
+	// The limit stops listing before the permissions of further roles are read
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	result := results[0]
+	if result.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", result.Diagnostics)
+	}
+	if result.DisplayName != "Admin" {
+		t.Errorf("expected display name Admin, got %s", result.DisplayName)
+	}
+
+	var identity resourceIDIdentityModel
+	result.Diagnostics.Append(result.Identity.Get(ctx, &identity)...)
+	var state RoleResourceModel
+	result.Diagnostics.Append(result.Resource.Get(ctx, &state)...)
+	if result.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", result.Diagnostics)
+	}
+
+	if identity.ID.ValueString() != "role_admin" {
+		t.Errorf("expected identity role_admin, got %s", identity.ID)
+	}
+	if state.Key.ValueString() != "admin" || state.Description.ValueString() != "Administrators" {
+		t.Errorf("unexpected role state: %+v", state)
+	}
+
+	var perms []string
+	result.Diagnostics.Append(state.Permissions.ElementsAs(ctx, &perms, false)...)
+	if len(perms) != 2 || perms[0] != "perm_read" || perms[1] != "perm_write" {
+		t.Errorf("expected sorted permissions [perm_read perm_write], got %v", perms)
+	}
+}
+
+func TestUserResource_ListEmptyNames(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/users":
+			_, _ = w.Write([]byte(`{"users":[{"id":"kp_bob","preferred_email":"bob@example.com"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/users/kp_bob/identities":
+			_, _ = w.Write([]byte(`{"identities":[{"type":"email","name":"bob@example.com"}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	ctx := context.Background()
+
+	r := &UserResource{}
+	var configureResp resource.ConfigureResponse
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: newProviderData(client)}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure error: %v", configureResp.Diagnostics)
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	req := list.ListRequest{
+		IncludeResource:        true,
+		Limit:                  10,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+
+	var stream list.ListResultsStream
+	r.List(ctx, req, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	result := results[0]
+	var state UserResourceModel
+	result.Diagnostics.Append(result.Resource.Get(ctx, &state)...)
+	if result.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", result.Diagnostics)
+	}
+
+	// Names are required, so empty names must not be null
+	if state.FirstName.IsNull() || state.FirstName.ValueString() != "" || state.LastName.IsNull() || state.LastName.ValueString() != "" {
+		t.Errorf("expected empty names, got first name %s and last name %s", state.FirstName, state.LastName)
+	}
+}
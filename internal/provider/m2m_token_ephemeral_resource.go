@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nxt-fwd/kinde-go"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &M2MTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &M2MTokenEphemeralResource{}
+)
+
+func NewM2MTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &M2MTokenEphemeralResource{}
+}
+
+type M2MTokenEphemeralResource struct {
+	domain string
+}
+
+type M2MTokenEphemeralResourceModel struct {
+	Domain       types.String `tfsdk:"domain"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Audience     types.String `tfsdk:"audience"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	AccessToken  types.String `tfsdk:"access_token"`
+	TokenType    types.String `tfsdk:"token_type"`
+	Scope        types.String `tfsdk:"scope"`
+	ExpiresIn    types.Int64  `tfsdk:"expires_in"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+}
+
+// m2mTokenResponse is the body returned by the Kinde token endpoint.
+type m2mTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+	TokenType   string `json:"token_type"`
+}
+
+func (r *M2MTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_m2m_token"
+}
+
+func (r *M2MTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exchanges the credentials of an M2M application for a short-lived access token using the `client_credentials` grant. The token is never persisted to plan or state. See [documentation](https://docs.kinde.com/developer-tools/kinde-api/access-token-for-api/) for more details.",
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Kinde domain to request the token from, e.g. `https://example.kinde.com`. Defaults to the provider domain.",
+				Optional:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client id of the M2M application",
+				Required:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret of the M2M application",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"audience": schema.StringAttribute{
+				MarkdownDescription: "Audience of the API the token is requested for",
+				Required:            true,
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes to request for the token",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "The issued access token",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"token_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the issued token",
+				Computed:            true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Space separated scopes granted to the token",
+				Computed:            true,
+			},
+			"expires_in": schema.Int64Attribute{
+				MarkdownDescription: "Lifetime of the token in seconds",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 timestamp at which the token expires",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *M2MTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	opts, ok := req.ProviderData.(*kinde.ClientOptions)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *kinde.ClientOptions, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.domain = opts.Domain
+}
+
+func (r *M2MTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data M2MTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := r.domain
+	if !data.Domain.IsNull() {
+		domain = data.Domain.ValueString()
+	}
+
+	if domain == "" {
+		resp.Diagnostics.AddError(
+			"Missing Domain",
+			"A domain must be set either on the ephemeral resource or on the provider.",
+		)
+		return
+	}
+
+	var scopes []string
+	if !data.Scopes.IsNull() {
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	token, err := requestM2MToken(ctx, domain, data.ClientID.ValueString(), data.ClientSecret.ValueString(), data.Audience.ValueString(), scopes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Requesting Access Token",
+			fmt.Sprintf("Could not exchange client credentials for client ID %s: %s", data.ClientID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Issued M2M access token", map[string]interface{}{
+		"client_id":  data.ClientID.ValueString(),
+		"expires_in": token.ExpiresIn,
+	})
+
+	data.Domain = types.StringValue(domain)
+	data.AccessToken = types.StringValue(token.AccessToken)
+	data.TokenType = types.StringValue(token.TokenType)
+	data.Scope = types.StringValue(token.Scope)
+	data.ExpiresIn = types.Int64Value(token.ExpiresIn)
+	data.ExpiresAt = types.StringValue(time.Now().Add(time.Duration(token.ExpiresIn) * time.Second).UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// requestM2MToken performs the client_credentials exchange against the token
+// endpoint of the given domain.
+func requestM2MToken(ctx context.Context, domain, clientID, clientSecret, audience string, scopes []string) (*m2mTokenResponse, error) {
+	body := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"audience":      {audience},
+	}
+	if len(scopes) > 0 {
+		body.Set("scope", strings.Join(scopes, " "))
+	}
+
+	tokenEndpoint := strings.TrimSuffix(domain, "/") + "/oauth2/token"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(body.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer res.Body.Close()
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", res.StatusCode, string(raw))
+	}
+
+	var token m2mTokenResponse
+	if err := json.Unmarshal(raw, &token); err != nil {
+		return nil, fmt.Errorf("failed to parse response body: %w", err)
+	}
+
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("token endpoint returned no access token")
+	}
+
+	return &token, nil
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+)
+
+var (
+	_ resource.Resource                = &OrganizationConnectionResource{}
+	_ resource.ResourceWithImportState = &OrganizationConnectionResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationConnectionResource{}
+)
+
+func NewOrganizationConnectionResource() resource.Resource {
+	return &OrganizationConnectionResource{}
+}
+
+type OrganizationConnectionResource struct {
+	client *organizations.Client
+}
+
+type organizationConnectionResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationCode types.String `tfsdk:"organization_code"`
+	ConnectionID     types.String `tfsdk:"connection_id"`
+}
+
+// organizationConnectionResourceIdentityModel identifies an enabled connection by
+// organization and connection.
+type organizationConnectionResourceIdentityModel struct {
+	OrganizationCode types.String `tfsdk:"organization_code"`
+	ConnectionID     types.String `tfsdk:"connection_id"`
+}
+
+func organizationConnectionIdentity(model organizationConnectionResourceModel) organizationConnectionResourceIdentityModel {
+	return organizationConnectionResourceIdentityModel{
+		OrganizationCode: model.OrganizationCode,
+		ConnectionID:     model.ConnectionID,
+	}
+}
+
+// organizationConnection represents a connection enabled for an organization.
+// The organizations client does not model organization connections yet.
+type organizationConnection struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Strategy    string `json:"strategy"`
+}
+
+func (r *OrganizationConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_connection"
+}
+
+func (r *OrganizationConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a connection for a Kinde organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Composite ID of the organization connection",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"organization_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the organization",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"connection_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the connection to enable",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *OrganizationConnectionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization_code": identityschema.StringAttribute{
+				Description:       "Code of the organization",
+				RequiredForImport: true,
+			},
+			"connection_id": identityschema.StringAttribute{
+				Description:       "ID of the connection",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Organizations
+}
+
+func (r *OrganizationConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan organizationConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Enable the connection
+	err := r.enableConnection(ctx, plan.OrganizationCode.ValueString(), plan.ConnectionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Enabling Connection",
+			fmt.Sprintf("Could not enable connection ID %s for organization %s: %s", plan.ConnectionID.ValueString(), plan.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+
+	// Set ID
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", plan.OrganizationCode.ValueString(), plan.ConnectionID.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, organizationConnectionIdentity(plan))...)
+}
+
+func (r *OrganizationConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state organizationConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get organization connections
+	connections, err := r.getConnections(ctx, state.OrganizationCode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization Connections",
+			fmt.Sprintf("Could not read connections for organization %s: %s", state.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+
+	// Check if our connection is still enabled
+	found := false
+	for _, conn := range connections {
+		if conn.ID == state.ConnectionID.ValueString() {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, organizationConnectionIdentity(state))...)
+}
+
+func (r *OrganizationConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No updates are possible, all fields require replacement
+	var plan organizationConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, organizationConnectionIdentity(plan))...)
+}
+
+func (r *OrganizationConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.disableConnection(ctx, state.OrganizationCode.ValueString(), state.ConnectionID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Disabling Connection",
+			fmt.Sprintf("Could not disable connection ID %s for organization %s: %s", state.ConnectionID.ValueString(), state.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *OrganizationConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCompositeID(ctx, req, resp, "organization_code", "connection_id")
+}
+
+// getConnections retrieves all connections enabled for an organization.
+func (r *OrganizationConnectionResource) getConnections(ctx context.Context, orgCode string) ([]organizationConnection, error) {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/connections", orgCode)
+	request, err := r.client.NewRequest(ctx, http.MethodGet, endpoint, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Code        string                   `json:"code"`
+		Message     string                   `json:"message"`
+		Connections []organizationConnection `json:"connections"`
+	}
+	if err := r.client.DoRequest(request, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Connections, nil
+}
+
+// enableConnection enables a connection for an organization.
+func (r *OrganizationConnectionResource) enableConnection(ctx context.Context, orgCode, connectionID string) error {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/connections/%s", orgCode, connectionID)
+	request, err := r.client.NewRequest(ctx, http.MethodPost, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return r.client.DoRequest(request, nil)
+}
+
+// disableConnection disables a connection for an organization.
+func (r *OrganizationConnectionResource) disableConnection(ctx context.Context, orgCode, connectionID string) error {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/connections/%s", orgCode, connectionID)
+	request, err := r.client.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return r.client.DoRequest(request, nil)
+}
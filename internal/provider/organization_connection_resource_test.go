@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccOrganizationConnectionResource(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationConnectionResourceConfig(testID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("kinde_organization_connection.test", "organization_code", "kinde_organization.test", "code"),
+					resource.TestCheckResourceAttrPair("kinde_organization_connection.test", "connection_id", "kinde_connection.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kinde_organization_connection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrganizationConnectionResource_Identity(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Resource identity requires Terraform 1.12
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConnectionResourceConfig(testID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("kinde_organization_connection.test", tfjsonpath.New("organization_code")),
+					statecheck.ExpectIdentityValueMatchesState("kinde_organization_connection.test", tfjsonpath.New("connection_id")),
+				},
+			},
+			// Import with an identity instead of an ID
+			{
+				ResourceName:    "kinde_organization_connection.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func testAccOrganizationConnectionResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "kinde_organization" "test" {
+	name = %[1]q
+}
+
+resource "kinde_connection" "test" {
+	name         = %[1]q
+	display_name = "Test OAuth2 Connection"
+	strategy     = "oauth2:google"
+	options = {
+		client_id     = "test-client-id"
+		client_secret = "test-client-secret"
+	}
+}
+
+resource "kinde_organization_connection" "test" {
+	organization_code = kinde_organization.test.code
+	connection_id     = kinde_connection.test.id
+}
+`, name)
+}
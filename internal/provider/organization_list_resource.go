@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+)
+
+var _ list.ListResourceWithConfigure = &OrganizationResource{}
+
+func NewOrganizationListResource() list.ListResource {
+	return &OrganizationResource{}
+}
+
+func (r *OrganizationResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceConfigSchema("Lists all Kinde organizations.")
+}
+
+func (r *OrganizationResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = listResults(ctx, req, "organizations", listAllOrganizations(ctx, r.client), func(ctx context.Context, org organizations.Organization, result *list.ListResult) {
+		result.DisplayName = org.Name
+		setListResult(ctx, req, result, types.StringValue(org.Code), func() (OrganizationResourceModel, diag.Diagnostics) {
+			var state OrganizationResourceModel
+			var diags diag.Diagnostics
+
+			// The organization list does not include branding or policy
+			organization, policy, err := r.getOrganization(ctx, org.Code)
+			if err != nil {
+				diags.AddError(
+					"Error Reading Organization",
+					fmt.Sprintf("Could not read organization code %s: %s", org.Code, err),
+				)
+				return state, diags
+			}
+
+			flattenOrganizationResource(organization, &state)
+			state.DefaultRoles = types.SetNull(types.StringType)
+			diags.Append(flattenOrganizationPolicy(ctx, policy, &state)...)
+			return state, diags
+		})
+	})
+}
@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+)
+
+var (
+	_ resource.Resource                   = &OrganizationLogoResource{}
+	_ resource.ResourceWithImportState    = &OrganizationLogoResource{}
+	_ resource.ResourceWithIdentity       = &OrganizationLogoResource{}
+	_ resource.ResourceWithModifyPlan     = &OrganizationLogoResource{}
+	_ resource.ResourceWithValidateConfig = &OrganizationLogoResource{}
+)
+
+// organizationLogoTypes are the logo variants an organization can have.
+var organizationLogoTypes = []string{"light", "dark"}
+
+func NewOrganizationLogoResource() resource.Resource {
+	return &OrganizationLogoResource{}
+}
+
+type OrganizationLogoResource struct {
+	client *organizations.Client
+}
+
+type OrganizationLogoResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationCode types.String `tfsdk:"organization_code"`
+	Type             types.String `tfsdk:"type"`
+	Source           types.String `tfsdk:"source"`
+	ContentHash      types.String `tfsdk:"content_hash"`
+}
+
+// OrganizationLogoResourceIdentityModel identifies a logo by organization and logo
+// type.
+type OrganizationLogoResourceIdentityModel struct {
+	OrganizationCode types.String `tfsdk:"organization_code"`
+	Type             types.String `tfsdk:"type"`
+}
+
+func organizationLogoIdentity(model OrganizationLogoResourceModel) OrganizationLogoResourceIdentityModel {
+	return OrganizationLogoResourceIdentityModel{
+		OrganizationCode: model.OrganizationCode,
+		Type:             model.Type,
+	}
+}
+
+func (r *OrganizationLogoResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_logo"
+}
+
+func (r *OrganizationLogoResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the light or dark mode logo of a Kinde organization. The logo is uploaded again whenever the content of the source file changes.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Composite ID of the organization logo",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"organization_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the organization",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the logo, either `light` or `dark`",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path to the logo image file to upload",
+				Required:            true,
+			},
+			"content_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA256 hash of the content of the source file, used to detect changes",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationLogoResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization_code": identityschema.StringAttribute{
+				Description:       "Code of the organization",
+				RequiredForImport: true,
+			},
+			"type": identityschema.StringAttribute{
+				Description:       "Type of the logo, light or dark",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationLogoResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Organizations
+}
+
+func (r *OrganizationLogoResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OrganizationLogoResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(organizationLogoTypes, data.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Logo Type",
+			fmt.Sprintf("type must be one of: %s, got %s.", strings.Join(organizationLogoTypes, ", "), data.Type.ValueString()),
+		)
+	}
+}
+
+func (r *OrganizationLogoResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan OrganizationLogoResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The file may be generated during apply
+	if plan.Source.IsUnknown() {
+		plan.ContentHash = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	content, err := os.ReadFile(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Error Reading Logo File",
+			fmt.Sprintf("Could not read logo file %s: %s", plan.Source.ValueString(), err),
+		)
+		return
+	}
+
+	plan.ContentHash = types.StringValue(hashContent(content))
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationLogoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationLogoResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contentHash, err := r.uploadLogo(ctx, plan.OrganizationCode.ValueString(), plan.Type.ValueString(), plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Uploading Logo",
+			fmt.Sprintf("Could not upload %s logo for organization %s: %s", plan.Type.ValueString(), plan.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", plan.OrganizationCode.ValueString(), plan.Type.ValueString()))
+	plan.ContentHash = types.StringValue(contentHash)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, organizationLogoIdentity(plan))...)
+}
+
+func (r *OrganizationLogoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationLogoResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := r.client.Get(ctx, state.OrganizationCode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization",
+			fmt.Sprintf("Could not read organization code %s: %s", state.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+
+	// The API only returns the logo location, so the content hash is kept from state
+	logo := organization.Logo
+	if state.Type.ValueString() == "dark" {
+		logo = organization.LogoDark
+	}
+
+	if logo == nil || *logo == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, organizationLogoIdentity(state))...)
+}
+
+func (r *OrganizationLogoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OrganizationLogoResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Uploading replaces the existing logo
+	contentHash, err := r.uploadLogo(ctx, plan.OrganizationCode.ValueString(), plan.Type.ValueString(), plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Uploading Logo",
+			fmt.Sprintf("Could not upload %s logo for organization %s: %s", plan.Type.ValueString(), plan.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+
+	plan.ContentHash = types.StringValue(contentHash)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, organizationLogoIdentity(plan))...)
+}
+
+func (r *OrganizationLogoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationLogoResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/logos/%s", state.OrganizationCode.ValueString(), state.Type.ValueString())
+	request, err := r.client.NewRequest(ctx, http.MethodDelete, endpoint, nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Request",
+			fmt.Sprintf("Could not create request to delete organization logo: %s", err),
+		)
+		return
+	}
+
+	if err := r.client.DoRequest(request, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Logo",
+			fmt.Sprintf("Could not delete %s logo for organization %s: %s", state.Type.ValueString(), state.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *OrganizationLogoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCompositeID(ctx, req, resp, "organization_code", "type")
+}
+
+// uploadLogo uploads the file at source as the logo of the given type and
+// returns the hash of the uploaded content.
+func (r *OrganizationLogoResource) uploadLogo(ctx context.Context, orgCode, logoType, source string) (string, error) {
+	content, err := os.ReadFile(source)
+	if err != nil {
+		return "", fmt.Errorf("failed to read logo file: %w", err)
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="logo"; filename=%q`, filepath.Base(source)))
+	header.Set("Content-Type", http.DetectContentType(content))
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return "", fmt.Errorf("failed to create multipart body: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return "", fmt.Errorf("failed to write multipart body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("failed to write multipart body: %w", err)
+	}
+
+	// The client only builds JSON requests, so the body is replaced after the
+	// request has been created to keep its authentication
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/logos/%s", orgCode, logoType)
+	request, err := r.client.NewRequest(ctx, http.MethodPost, endpoint, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Body = io.NopCloser(&body)
+	request.ContentLength = int64(body.Len())
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+
+	if err := r.client.DoRequest(request, nil); err != nil {
+		return "", err
+	}
+
+	return hashContent(content), nil
+}
+
+// hashContent returns the hex encoded SHA256 hash of content.
+func hashContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccLogoPNG is a 1x1 transparent PNG.
+const testAccLogoPNG = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+
+func TestAccOrganizationLogoResource(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+	source := filepath.Join(t.TempDir(), "logo.png")
+
+	content, err := base64.StdEncoding.DecodeString(testAccLogoPNG)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(source, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationLogoResourceConfig(testID, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("kinde_organization_logo.test", "organization_code", "kinde_organization.test", "code"),
+					resource.TestCheckResourceAttr("kinde_organization_logo.test", "type", "dark"),
+					resource.TestCheckResourceAttr("kinde_organization_logo.test", "content_hash", hashContent(content)),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "kinde_organization_logo.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source", "content_hash"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrganizationLogoResourceConfig(name, source string) string {
+	return fmt.Sprintf(`
+resource "kinde_organization" "test" {
+	name = %[1]q
+}
+
+resource "kinde_organization_logo" "test" {
+	organization_code = kinde_organization.test.code
+	type              = "dark"
+	source            = %[2]q
+}
+`, name, source)
+}
@@ -3,20 +3,25 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/nxt-fwd/kinde-go"
 	"github.com/nxt-fwd/kinde-go/api/organizations"
 )
 
 var (
-	_ resource.Resource                = &OrganizationResource{}
-	_ resource.ResourceWithImportState = &OrganizationResource{}
+	_ resource.Resource                   = &OrganizationResource{}
+	_ resource.ResourceWithImportState    = &OrganizationResource{}
+	_ resource.ResourceWithIdentity       = &OrganizationResource{}
+	_ resource.ResourceWithValidateConfig = &OrganizationResource{}
 )
 
 func NewOrganizationResource() resource.Resource {
@@ -25,6 +30,7 @@ func NewOrganizationResource() resource.Resource {
 
 type OrganizationResource struct {
 	client *organizations.Client
+	cache  *providerCache
 }
 
 type OrganizationResourceModel struct {
@@ -39,6 +45,17 @@ type OrganizationResourceModel struct {
 	ThemeCode       types.String `tfsdk:"theme_code"`
 	Handle          types.String `tfsdk:"handle"`
 	CreatedOn       types.String `tfsdk:"created_on"`
+
+	BackgroundColorDark types.String `tfsdk:"background_color_dark"`
+	ButtonColorDark     types.String `tfsdk:"button_color_dark"`
+	ButtonTextColorDark types.String `tfsdk:"button_text_color_dark"`
+	LinkColorDark       types.String `tfsdk:"link_color_dark"`
+
+	AllowRegistrations    types.Bool `tfsdk:"allow_registrations"`
+	AutoMembershipEnabled types.Bool `tfsdk:"auto_membership_enabled"`
+	AllowedDomains        types.Set  `tfsdk:"allowed_domains"`
+	DefaultRoles          types.Set  `tfsdk:"default_roles"`
+	CreateBillingCustomer types.Bool `tfsdk:"create_billing_customer"`
 }
 
 func (r *OrganizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -57,10 +74,12 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"code": schema.StringAttribute{
-				Description: "The organization code.",
+				Description: "The organization code. Generated by Kinde unless set, changing it forces a new organization.",
+				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -76,7 +95,7 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"background_color": schema.StringAttribute{
-				Description: "The background color of the organization's theme.",
+				Description: "The background color of the organization's theme, as a hex value.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
@@ -84,7 +103,7 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"button_color": schema.StringAttribute{
-				Description: "The button color of the organization's theme.",
+				Description: "The button color of the organization's theme, as a hex value.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
@@ -92,7 +111,7 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"button_text_color": schema.StringAttribute{
-				Description: "The button text color of the organization's theme.",
+				Description: "The button text color of the organization's theme, as a hex value.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
@@ -100,7 +119,39 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"link_color": schema.StringAttribute{
-				Description: "The link color of the organization's theme.",
+				Description: "The link color of the organization's theme, as a hex value.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"background_color_dark": schema.StringAttribute{
+				Description: "The background color of the organization's theme in dark mode, as a hex value.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"button_color_dark": schema.StringAttribute{
+				Description: "The button color of the organization's theme in dark mode, as a hex value.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"button_text_color_dark": schema.StringAttribute{
+				Description: "The button text color of the organization's theme in dark mode, as a hex value.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"link_color_dark": schema.StringAttribute{
+				Description: "The link color of the organization's theme in dark mode, as a hex value.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
@@ -108,7 +159,7 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"theme_code": schema.StringAttribute{
-				Description: "The theme code of the organization.",
+				Description: "The theme code of the organization, one of `light`, `dark` or `user_preference`.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
@@ -130,25 +181,68 @@ func (r *OrganizationResource) Schema(_ context.Context, _ resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"allow_registrations": schema.BoolAttribute{
+				Description: "Whether users can sign up to the organization.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_membership_enabled": schema.BoolAttribute{
+				Description: "Whether users with an email address in one of the allowed domains automatically become members of the organization.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allowed_domains": schema.SetAttribute{
+				Description: "The email domains used for auto-membership.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_roles": schema.SetAttribute{
+				Description: "IDs of the roles that new members are given. Kinde applies default roles to new members of every organization in the environment, so set this on one organization only. Default roles are left unchanged if not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"create_billing_customer": schema.BoolAttribute{
+				Description: "Whether a billing customer is created for the organization. Only used when the organization is created, changing it later has no effect.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
+func (r *OrganizationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIDIdentitySchema("Code of the organization")
+}
+
 func (r *OrganizationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*kinde.Client)
+	client, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *kinde.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	r.client = client.Organizations
+	r.cache = client.cache
 }
 
 func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -159,12 +253,11 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	createParams := organizations.CreateParams{
-		Name:   plan.Name.ValueString(),
-		Handle: plan.Handle.ValueString(),
-	}
+	// Unknown values of optional computed attributes are sent as empty strings
+	// and therefore omitted
+	createParams := expandOrganizationCreateParams(plan)
 
-	organization, err := r.client.Create(ctx, createParams)
+	organization, err := r.createOrganization(ctx, createParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Organization",
@@ -173,70 +266,68 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	// Get the created organization to ensure we have all fields
-	organization, err = r.client.Get(ctx, organization.Code)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Organization",
-			fmt.Sprintf("Could not read organization code %s: %s", organization.Code, err),
-		)
+	r.cache.InvalidateOrganizations()
+	code := organization.Code
+
+	// Keep track of the organization before the remaining requests, so
+	// Terraform marks it as tainted and replaces it if one of them fails
+	// instead of trying to create the same code again
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), code)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("code"), code)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(types.StringValue(code)))...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Set values from API response
-	if createParams.Code != "" {
-		plan.Code = types.StringValue(createParams.Code)
-		plan.ID = types.StringValue(createParams.Code)
-	} else {
-		plan.Code = types.StringValue(organization.Code)
-		plan.ID = types.StringValue(organization.Code)
-	}
-	plan.Name = types.StringValue(organization.Name)
-	plan.CreatedOn = types.StringValue(organization.CreatedOn.Format(time.RFC3339))
-	plan.ThemeCode = types.StringValue(organization.ColorScheme)
-
-	// Handle optional values
-	if organization.Handle != nil {
-		plan.Handle = types.StringValue(*organization.Handle)
-	} else if createParams.Handle != "" {
-		// Fallback to plan value if API doesn't return it
-		plan.Handle = types.StringValue(createParams.Handle)
-	} else {
-		plan.Handle = types.StringNull()
+	// Sign-up and membership settings are not accepted on creation
+	policyParams, diags := expandOrganizationPolicyParams(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if organization.ExternalID != nil {
-		plan.ExternalID = types.StringValue(*organization.ExternalID)
-	} else {
-		plan.ExternalID = types.StringNull()
+	if policyParams != nil {
+		if err := r.updatePolicy(ctx, code, *policyParams); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Organization Policy",
+				fmt.Sprintf("Could not update sign-up and membership settings of organization code %s: %s", code, err),
+			)
+			return
+		}
 	}
 
-	if organization.BackgroundColor != nil {
-		plan.BackgroundColor = types.StringValue(organization.BackgroundColor.Hex)
-	} else {
-		plan.BackgroundColor = types.StringNull()
+	resp.Diagnostics.Append(r.updateDefaultRoles(ctx, plan.DefaultRoles)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if organization.ButtonColor != nil {
-		plan.ButtonColor = types.StringValue(organization.ButtonColor.Hex)
-	} else {
-		plan.ButtonColor = types.StringNull()
+	// Get the created organization to ensure we have all fields
+	organization, policy, err := r.getOrganization(ctx, code)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization",
+			fmt.Sprintf("Could not read organization code %s: %s", code, err),
+		)
+		return
 	}
 
-	if organization.ButtonTextColor != nil {
-		plan.ButtonTextColor = types.StringValue(organization.ButtonTextColor.Hex)
-	} else {
-		plan.ButtonTextColor = types.StringNull()
+	// Set values from API response
+	flattenOrganizationResource(organization, &plan)
+
+	// Fallback to plan value if API doesn't return the handle
+	if organization.Handle == nil && createParams.Handle != "" {
+		plan.Handle = types.StringValue(createParams.Handle)
 	}
 
-	if organization.LinkColor != nil {
-		plan.LinkColor = types.StringValue(organization.LinkColor.Hex)
-	} else {
-		plan.LinkColor = types.StringNull()
+	resp.Diagnostics.Append(flattenOrganizationPolicy(ctx, policy, &plan)...)
+	resp.Diagnostics.Append(r.readDefaultRoles(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(plan.ID))...)
 }
 
 func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -247,61 +338,49 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	organization, err := r.client.Get(ctx, state.Code.ValueString())
+	// Organizations deleted outside of Terraform are removed from state. The
+	// cached list saves a request per deleted organization, but it does not
+	// include branding or policy, so existing organizations are still read
+	// one by one.
+	orgs, err := r.cache.Organizations(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error Reading Organization",
-			fmt.Sprintf("Could not read organization code %s: %s", state.Code.ValueString(), err),
+			"Error Reading Organizations",
+			fmt.Sprintf("Could not read organizations: %s", err),
 		)
 		return
 	}
-
-	// Set known values
-	state.Code = types.StringValue(organization.Code)
-	state.ID = types.StringValue(organization.Code)
-	state.Name = types.StringValue(organization.Name)
-	state.CreatedOn = types.StringValue(organization.CreatedOn.Format(time.RFC3339))
-	state.ThemeCode = types.StringValue(organization.ColorScheme)
-
-	// Handle optional values
-	if organization.Handle != nil {
-		state.Handle = types.StringValue(*organization.Handle)
-	} else {
-		state.Handle = types.StringNull()
-	}
-
-	if organization.ExternalID != nil {
-		state.ExternalID = types.StringValue(*organization.ExternalID)
-	} else {
-		state.ExternalID = types.StringNull()
+	if !slices.ContainsFunc(orgs, func(org organizations.Organization) bool { return org.Code == state.Code.ValueString() }) {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	if organization.BackgroundColor != nil {
-		state.BackgroundColor = types.StringValue(organization.BackgroundColor.Hex)
-	} else {
-		state.BackgroundColor = types.StringNull()
-	}
+	organization, policy, err := r.getOrganization(ctx, state.Code.ValueString())
+	if err != nil {
+		// Deleted since the list was cached
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 
-	if organization.ButtonColor != nil {
-		state.ButtonColor = types.StringValue(organization.ButtonColor.Hex)
-	} else {
-		state.ButtonColor = types.StringNull()
+		resp.Diagnostics.AddError(
+			"Error Reading Organization",
+			fmt.Sprintf("Could not read organization code %s: %s", state.Code.ValueString(), err),
+		)
+		return
 	}
 
-	if organization.ButtonTextColor != nil {
-		state.ButtonTextColor = types.StringValue(organization.ButtonTextColor.Hex)
-	} else {
-		state.ButtonTextColor = types.StringNull()
-	}
+	flattenOrganizationResource(organization, &state)
 
-	if organization.LinkColor != nil {
-		state.LinkColor = types.StringValue(organization.LinkColor.Hex)
-	} else {
-		state.LinkColor = types.StringNull()
+	resp.Diagnostics.Append(flattenOrganizationPolicy(ctx, policy, &state)...)
+	resp.Diagnostics.Append(r.readDefaultRoles(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 }
 
 func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -313,14 +392,18 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 
 	updateParams := organizations.UpdateParams{
-		Name:            plan.Name.ValueString(),
-		ExternalID:      plan.ExternalID.ValueString(),
-		BackgroundColor: plan.BackgroundColor.ValueString(),
-		ButtonColor:     plan.ButtonColor.ValueString(),
-		ButtonTextColor: plan.ButtonTextColor.ValueString(),
-		LinkColor:       plan.LinkColor.ValueString(),
-		ThemeCode:       plan.ThemeCode.ValueString(),
-		Handle:          plan.Handle.ValueString(),
+		Name:                plan.Name.ValueString(),
+		ExternalID:          plan.ExternalID.ValueString(),
+		BackgroundColor:     expandOrganizationColor(plan.BackgroundColor),
+		ButtonColor:         expandOrganizationColor(plan.ButtonColor),
+		ButtonTextColor:     expandOrganizationColor(plan.ButtonTextColor),
+		LinkColor:           expandOrganizationColor(plan.LinkColor),
+		BackgroundColorDark: expandOrganizationColor(plan.BackgroundColorDark),
+		ButtonColorDark:     expandOrganizationColor(plan.ButtonColorDark),
+		ButtonTextColorDark: expandOrganizationColor(plan.ButtonTextColorDark),
+		LinkColorDark:       expandOrganizationColor(plan.LinkColorDark),
+		ThemeCode:           plan.ThemeCode.ValueString(),
+		Handle:              plan.Handle.ValueString(),
 	}
 
 	organization, err := r.client.Update(ctx, plan.Code.ValueString(), updateParams)
@@ -332,52 +415,98 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	// Set known values
-	plan.Code = types.StringValue(organization.Code)
-	plan.ID = types.StringValue(organization.Code)
-	plan.Name = types.StringValue(organization.Name)
-	plan.CreatedOn = types.StringValue(organization.CreatedOn.Format(time.RFC3339))
-	plan.ThemeCode = types.StringValue(organization.ColorScheme)
+	r.cache.InvalidateOrganizations()
 
-	// Handle optional values
-	if organization.Handle != nil {
-		plan.Handle = types.StringValue(*organization.Handle)
-	} else {
-		plan.Handle = types.StringNull()
+	policyParams, diags := expandOrganizationPolicyParams(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if organization.ExternalID != nil {
-		plan.ExternalID = types.StringValue(*organization.ExternalID)
-	} else {
-		plan.ExternalID = types.StringNull()
+	if policyParams != nil {
+		if err := r.updatePolicy(ctx, plan.Code.ValueString(), *policyParams); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Organization Policy",
+				fmt.Sprintf("Could not update sign-up and membership settings of organization code %s: %s", plan.Code.ValueString(), err),
+			)
+			return
+		}
 	}
 
-	if organization.BackgroundColor != nil {
-		plan.BackgroundColor = types.StringValue(organization.BackgroundColor.Hex)
-	} else {
-		plan.BackgroundColor = types.StringNull()
+	resp.Diagnostics.Append(r.updateDefaultRoles(ctx, plan.DefaultRoles)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if organization.ButtonColor != nil {
-		plan.ButtonColor = types.StringValue(organization.ButtonColor.Hex)
-	} else {
-		plan.ButtonColor = types.StringNull()
+	// The update response does not include the policy
+	organization, policy, err := r.getOrganization(ctx, plan.Code.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization",
+			fmt.Sprintf("Could not read organization code %s: %s", plan.Code.ValueString(), err),
+		)
+		return
 	}
 
-	if organization.ButtonTextColor != nil {
-		plan.ButtonTextColor = types.StringValue(organization.ButtonTextColor.Hex)
-	} else {
-		plan.ButtonTextColor = types.StringNull()
-	}
+	flattenOrganizationResource(organization, &plan)
 
-	if organization.LinkColor != nil {
-		plan.LinkColor = types.StringValue(organization.LinkColor.Hex)
-	} else {
-		plan.LinkColor = types.StringNull()
+	resp.Diagnostics.Append(flattenOrganizationPolicy(ctx, policy, &plan)...)
+	resp.Diagnostics.Append(r.readDefaultRoles(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(plan.ID))...)
+}
+
+func (r *OrganizationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OrganizationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	colors := map[string]types.String{
+		"background_color":       data.BackgroundColor,
+		"button_color":           data.ButtonColor,
+		"button_text_color":      data.ButtonTextColor,
+		"link_color":             data.LinkColor,
+		"background_color_dark":  data.BackgroundColorDark,
+		"button_color_dark":      data.ButtonColorDark,
+		"button_text_color_dark": data.ButtonTextColorDark,
+		"link_color_dark":        data.LinkColorDark,
+	}
+	for name, color := range colors {
+		if color.IsNull() || color.IsUnknown() {
+			continue
+		}
+		if !hexColorRegexp.MatchString(color.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Color",
+				fmt.Sprintf("%s must be a hex color such as #0055ff or #05f, got %s.", name, color.ValueString()),
+			)
+		}
+	}
+
+	if !data.ThemeCode.IsNull() && !data.ThemeCode.IsUnknown() && !slices.Contains(organizationThemeCodes, data.ThemeCode.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("theme_code"),
+			"Invalid Theme Code",
+			fmt.Sprintf("theme_code must be one of: %s, got %s.", strings.Join(organizationThemeCodes, ", "), data.ThemeCode.ValueString()),
+		)
+	}
+
+	// Auto-membership matches users by email domain, so it needs at least one domain
+	if data.AutoMembershipEnabled.ValueBool() && !data.AllowedDomains.IsUnknown() && len(data.AllowedDomains.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allowed_domains"),
+			"Missing Allowed Domains",
+			"allowed_domains must contain at least one domain when auto_membership_enabled is true.",
+		)
+	}
 }
 
 func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -396,15 +525,23 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		)
 		return
 	}
+
+	r.cache.InvalidateOrganizations()
 }
 
 func (r *OrganizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	code, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get the organization by code
-	organization, err := r.client.Get(ctx, req.ID)
+	organization, policy, err := r.getOrganization(ctx, code)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Organization",
-			fmt.Sprintf("Could not read organization code %s: %s", req.ID, err),
+			fmt.Sprintf("Could not read organization code %s: %s", code, err),
 		)
 		return
 	}
@@ -412,51 +549,19 @@ func (r *OrganizationResource) ImportState(ctx context.Context, req resource.Imp
 	// Create a new state
 	var state OrganizationResourceModel
 
-	// Set known values
-	state.ID = types.StringValue(organization.Code)
-	state.Code = types.StringValue(organization.Code)
-	state.Name = types.StringValue(organization.Name)
-	state.CreatedOn = types.StringValue(organization.CreatedOn.Format(time.RFC3339))
-	state.ThemeCode = types.StringValue(organization.ColorScheme)
-
-	// Handle optional values
-	if organization.Handle != nil {
-		state.Handle = types.StringValue(*organization.Handle)
-	} else {
-		state.Handle = types.StringNull()
-	}
-
-	if organization.ExternalID != nil {
-		state.ExternalID = types.StringValue(*organization.ExternalID)
-	} else {
-		state.ExternalID = types.StringNull()
-	}
-
-	if organization.BackgroundColor != nil {
-		state.BackgroundColor = types.StringValue(organization.BackgroundColor.Hex)
-	} else {
-		state.BackgroundColor = types.StringNull()
-	}
-
-	if organization.ButtonColor != nil {
-		state.ButtonColor = types.StringValue(organization.ButtonColor.Hex)
-	} else {
-		state.ButtonColor = types.StringNull()
-	}
+	flattenOrganizationResource(organization, &state)
 
-	if organization.ButtonTextColor != nil {
-		state.ButtonTextColor = types.StringValue(organization.ButtonTextColor.Hex)
-	} else {
-		state.ButtonTextColor = types.StringNull()
-	}
+	// Default roles apply to the whole environment and stay unmanaged until
+	// they are configured
+	state.DefaultRoles = types.SetNull(types.StringType)
 
-	if organization.LinkColor != nil {
-		state.LinkColor = types.StringValue(organization.LinkColor.Hex)
-	} else {
-		state.LinkColor = types.StringNull()
+	resp.Diagnostics.Append(flattenOrganizationPolicy(ctx, policy, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Set the state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 }
@@ -1,11 +1,22 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
 )
 
 func TestAccOrganizationResource(t *testing.T) {
@@ -44,6 +55,170 @@ func TestAccOrganizationResource(t *testing.T) {
 	})
 }
 
+func TestAccOrganizationResource_Policy(t *testing.T) {
+	testName := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationResourceConfigPolicy(testName, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_organization.test", "allow_registrations", "true"),
+					resource.TestCheckResourceAttr("kinde_organization.test", "auto_membership_enabled", "true"),
+					resource.TestCheckResourceAttr("kinde_organization.test", "allowed_domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr("kinde_organization.test", "allowed_domains.*", "example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "kinde_organization.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_billing_customer"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationResourceConfigPolicy(testName, false, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_organization.test", "allow_registrations", "false"),
+					resource.TestCheckResourceAttr("kinde_organization.test", "auto_membership_enabled", "false"),
+				),
+			},
+			// Auto-membership needs allowed domains
+			{
+				Config: fmt.Sprintf(`
+resource "kinde_organization" "test" {
+	name                    = %[1]q
+	auto_membership_enabled = true
+	allowed_domains         = []
+}
+`, testName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`allowed_domains must contain at least one domain`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrganizationResource_DefaultRoles(t *testing.T) {
+	testName := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationResourceConfigDefaultRoles(testName, "[kinde_role.test.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_organization.test", "default_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("kinde_organization.test", "default_roles.*", "kinde_role.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationResourceConfigDefaultRoles(testName, "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_organization.test", "default_roles.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrganizationResource_Theme(t *testing.T) {
+	testName := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationResourceConfig(testName),
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationResourceConfigTheme(testName, "user_preference"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_organization.test", "theme_code", "user_preference"),
+					resource.TestCheckResourceAttr("kinde_organization.test", "background_color", "#ffffff"),
+					resource.TestCheckResourceAttr("kinde_organization.test", "background_color_dark", "#000000"),
+					resource.TestCheckResourceAttr("kinde_organization.test", "button_color_dark", "#0055ff"),
+					resource.TestCheckResourceAttr("kinde_organization.test", "button_text_color_dark", "#ffffff"),
+					resource.TestCheckResourceAttr("kinde_organization.test", "link_color_dark", "#66aaff"),
+				),
+			},
+			// Short and uppercase colors are kept as configured
+			{
+				Config: fmt.Sprintf(`
+resource "kinde_organization" "test" {
+	name             = %[1]q
+	background_color = "#fff"
+	link_color       = "#66AAFF"
+}
+`, testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_organization.test", "background_color", "#fff"),
+					resource.TestCheckResourceAttr("kinde_organization.test", "link_color", "#66AAFF"),
+				),
+			},
+			// Invalid values are rejected at plan time
+			{
+				Config: fmt.Sprintf(`
+resource "kinde_organization" "test" {
+	name         = %[1]q
+	button_color = "blue"
+}
+`, testName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`button_color must be a hex color`),
+			},
+			{
+				Config:      testAccOrganizationResourceConfigTheme(testName, "system"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`theme_code must be one of: light, dark, user_preference`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccOrganizationResource_CreateSettings(t *testing.T) {
+	testName := acctest.RandomWithPrefix("tfacc")
+	testCode := "org_" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationResourceConfigCreateSettings(testName, testCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_organization.test", "code", testCode),
+					resource.TestCheckResourceAttr("kinde_organization.test", "id", testCode),
+					resource.TestCheckResourceAttr("kinde_organization.test", "external_id", testName),
+					resource.TestCheckResourceAttr("kinde_organization.test", "button_color", "#0055ff"),
+					resource.TestCheckResourceAttr("kinde_organization.test", "theme_code", "dark"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kinde_organization.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccOrganizationResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "kinde_organization" "test" {
@@ -59,3 +234,361 @@ resource "kinde_organization" "test" {
 }
 `, name)
 }
+
+func testAccOrganizationResourceConfigPolicy(name string, allowRegistrations, autoMembership bool) string {
+	return fmt.Sprintf(`
+resource "kinde_organization" "test" {
+	name                    = %[1]q
+	allow_registrations     = %[2]t
+	auto_membership_enabled = %[3]t
+	allowed_domains         = ["example.com"]
+}
+`, name, allowRegistrations, autoMembership)
+}
+
+func testAccOrganizationResourceConfigDefaultRoles(name, defaultRoles string) string {
+	return fmt.Sprintf(`
+resource "kinde_role" "test" {
+	name = %[1]q
+	key  = %[1]q
+}
+
+resource "kinde_organization" "test" {
+	name          = %[1]q
+	default_roles = %[2]s
+}
+`, name, defaultRoles)
+}
+
+func testAccOrganizationResourceConfigTheme(name, themeCode string) string {
+	return fmt.Sprintf(`
+resource "kinde_organization" "test" {
+	name                   = %[1]q
+	theme_code             = %[2]q
+	background_color       = "#ffffff"
+	background_color_dark  = "#000000"
+	button_color_dark      = "#0055ff"
+	button_text_color_dark = "#ffffff"
+	link_color_dark        = "#66aaff"
+}
+`, name, themeCode)
+}
+
+func testAccOrganizationResourceConfigCreateSettings(name, code string) string {
+	return fmt.Sprintf(`
+resource "kinde_organization" "test" {
+	name         = %[1]q
+	code         = %[2]q
+	external_id  = %[1]q
+	button_color = "#0055ff"
+	theme_code   = "dark"
+}
+`, name, code)
+}
+
+func TestOrganizationColorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		sent       string
+	}{
+		{name: "short", configured: "#05f", sent: "#0055ff"},
+		{name: "uppercase", configured: "#FFAA00", sent: "#ffaa00"},
+		{name: "short uppercase", configured: "#FFF", sent: "#ffffff"},
+		{name: "normalized", configured: "#0055ff", sent: "#0055ff"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := expandOrganizationColor(types.StringValue(tt.configured))
+			if sent != tt.sent {
+				t.Errorf("expected %s to be sent as %s, got %s", tt.configured, tt.sent, sent)
+			}
+
+			// The API returns the color it was sent
+			actual := flattenOrganizationColor(&organizations.Color{Hex: sent}, types.StringValue(tt.configured))
+			if actual.ValueString() != tt.configured {
+				t.Errorf("expected configured color %s to be kept, got %s", tt.configured, actual.ValueString())
+			}
+		})
+	}
+
+	// Create and update send the same notation
+	params := expandOrganizationCreateParams(OrganizationResourceModel{
+		BackgroundColor: types.StringValue("#05F"),
+		LinkColorDark:   types.StringValue("#FFAA00"),
+		ButtonColor:     types.StringUnknown(),
+	})
+	if params.BackgroundColor != "#0055ff" || params.LinkColorDark != "#ffaa00" || params.ButtonColor != "" {
+		t.Errorf("expected normalized colors on create, got %+v", params)
+	}
+
+	if actual := flattenOrganizationColor(&organizations.Color{Hex: "#000000"}, types.StringValue("#FFF")); actual.ValueString() != "#000000" {
+		t.Errorf("expected changed color #000000, got %s", actual.ValueString())
+	}
+	if actual := flattenOrganizationColor(&organizations.Color{Hex: "#0055ff"}, types.StringNull()); actual.ValueString() != "#0055ff" {
+		t.Errorf("expected imported color #0055ff, got %s", actual.ValueString())
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Query().Get("code") == "org_missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"code":"ORGANIZATION_INVALID","message":"Organization not found"}]}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	client := newTestClient(server)
+
+	_, err := client.Organizations.Get(context.Background(), "org_missing")
+	if !isNotFoundError(err) {
+		t.Errorf("expected a not-found error, got %v", err)
+	}
+
+	// Any other failure keeps the organization in state
+	_, err = client.Organizations.Get(context.Background(), "org_broken")
+	if err == nil || isNotFoundError(err) {
+		t.Errorf("expected an error other than not-found, got %v", err)
+	}
+	if isNotFoundError(fmt.Errorf("wrapped: %w", err)) {
+		t.Errorf("expected wrapped server errors not to be reported as not-found")
+	}
+}
+
+func TestOrganizationResource_CreateKeepsOrganizationWhenPolicyFails(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/organization":
+			_, _ = w.Write([]byte(`{"code":"OK","organization":{"code":"org_acme","name":"Acme"}}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/organization/org_acme":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	r := &OrganizationResource{client: client.Organizations, cache: newProviderCache(client)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp fwresource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, OrganizationResourceModel{
+		ID:                    types.StringUnknown(),
+		Code:                  types.StringValue("org_acme"),
+		Name:                  types.StringValue("Acme"),
+		ThemeCode:             types.StringUnknown(),
+		Handle:                types.StringUnknown(),
+		CreatedOn:             types.StringUnknown(),
+		AllowRegistrations:    types.BoolValue(true),
+		AutoMembershipEnabled: types.BoolUnknown(),
+		AllowedDomains:        types.SetUnknown(types.StringType),
+		DefaultRoles:          types.SetNull(types.StringType),
+		CreateBillingCustomer: types.BoolUnknown(),
+	}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	resp := fwresource.CreateResponse{
+		State:    tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema},
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Error Updating Organization Policy" {
+		t.Fatalf("expected policy error, got %v", resp.Diagnostics)
+	}
+
+	// The organization is kept in state, so Terraform replaces it instead of
+	// creating the same code again
+	var state OrganizationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "org_acme" || state.Code.ValueString() != "org_acme" {
+		t.Errorf("expected organization org_acme in state, got %+v", state)
+	}
+}
+
+func TestOrganizationResource_DefaultRoles(t *testing.T) {
+	defaults := map[string]bool{"role_admin": true, "role_member": false}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/roles":
+			_, _ = fmt.Fprintf(w, `{"roles":[{"id":"role_admin","key":"admin","name":"Admin","is_default_role":%t},{"id":"role_member","key":"member","name":"Member","is_default_role":%t}]}`, defaults["role_admin"], defaults["role_member"])
+		case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, "/api/v1/roles/"):
+			var params defaultRoleParams
+			if err := json.NewDecoder(r.Body).Decode(&params); err != nil || params.Name == "" || params.Key == "" {
+				t.Errorf("expected name and key in role update, got %+v (%v)", params, err)
+			}
+			defaults[strings.TrimPrefix(r.URL.Path, "/api/v1/roles/")] = params.IsDefaultRole
+			_, _ = w.Write([]byte(`{"code":"OK"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	r := &OrganizationResource{client: client.Organizations, cache: newProviderCache(client)}
+	ctx := context.Background()
+
+	// Unset default roles are neither changed nor read
+	model := OrganizationResourceModel{DefaultRoles: types.SetNull(types.StringType)}
+	if diags := r.updateDefaultRoles(ctx, model.DefaultRoles); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags := r.readDefaultRoles(ctx, &model); diags.HasError() || !model.DefaultRoles.IsNull() {
+		t.Fatalf("expected unmanaged default roles, got %s (%v)", model.DefaultRoles, diags)
+	}
+
+	planned := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("role_member")})
+	if diags := r.updateDefaultRoles(ctx, planned); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if defaults["role_admin"] || !defaults["role_member"] {
+		t.Errorf("expected role_member to be the only default role, got %v", defaults)
+	}
+
+	model.DefaultRoles = planned
+	if diags := r.readDefaultRoles(ctx, &model); diags.HasError() || !model.DefaultRoles.Equal(planned) {
+		t.Errorf("expected default roles %s, got %s (%v)", planned, model.DefaultRoles, diags)
+	}
+
+	// Roles changed outside of Terraform are detected as drift
+	defaults["role_admin"] = true
+	r.cache.InvalidateRoles()
+	if diags := r.readDefaultRoles(ctx, &model); diags.HasError() || len(model.DefaultRoles.Elements()) != 2 {
+		t.Errorf("expected both default roles, got %s (%v)", model.DefaultRoles, diags)
+	}
+
+	unknown := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("role_missing")})
+	if diags := r.updateDefaultRoles(ctx, unknown); !diags.HasError() || diags.Errors()[0].Summary() != "Unknown Default Role" {
+		t.Errorf("expected unknown default role error, got %v", diags)
+	}
+}
+
+func TestOrganizationResource_ReadDefaultRolesDrift(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/organizations":
+			_, _ = w.Write([]byte(`{"organizations":[{"code":"org_acme","name":"Acme"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/organization":
+			_, _ = w.Write([]byte(`{"code":"org_acme","name":"Acme","color_scheme":"light"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/roles":
+			_, _ = w.Write([]byte(`{"roles":[{"id":"role_admin","key":"admin","is_default_role":false},{"id":"role_member","key":"member","is_default_role":true}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	r := &OrganizationResource{client: client.Organizations, cache: newProviderCache(client)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp fwresource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, OrganizationResourceModel{
+		ID:             types.StringValue("org_acme"),
+		Code:           types.StringValue("org_acme"),
+		Name:           types.StringValue("Acme"),
+		AllowedDomains: types.SetNull(types.StringType),
+		DefaultRoles:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("role_admin")}),
+	}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	resp := fwresource.ReadResponse{
+		State:    state,
+		Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil)},
+	}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var model OrganizationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	want := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("role_member")})
+	if !model.DefaultRoles.Equal(want) {
+		t.Errorf("expected default roles %s, got %s", want, model.DefaultRoles)
+	}
+}
+
+func TestOrganizationResource_ReadUsesCachedList(t *testing.T) {
+	requests := map[string]int{}
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/organizations":
+			_, _ = w.Write([]byte(`{"organizations":[{"code":"org_acme","name":"Acme"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/organization" && r.URL.Query().Get("code") == "org_acme":
+			_, _ = w.Write([]byte(`{"code":"org_acme","name":"Acme","color_scheme":"light","is_allow_registrations":true}`))
+		default:
+			t.Errorf("unexpected request: %s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	r := &OrganizationResource{client: client.Organizations, cache: newProviderCache(client)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp fwresource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	read := func(code string) fwresource.ReadResponse {
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, OrganizationResourceModel{
+			ID:             types.StringValue(code),
+			Code:           types.StringValue(code),
+			Name:           types.StringValue("Acme"),
+			AllowedDomains: types.SetNull(types.StringType),
+			DefaultRoles:   types.SetNull(types.StringType),
+		}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		resp := fwresource.ReadResponse{
+			State:    state,
+			Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil)},
+		}
+		r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		return resp
+	}
+
+	// Organizations missing from the list are removed without reading them
+	if resp := read("org_deleted"); !resp.State.Raw.IsNull() {
+		t.Errorf("expected org_deleted to be removed, got %s", resp.State.Raw)
+	}
+
+	resp := read("org_acme")
+	var model OrganizationResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	if !model.AllowRegistrations.ValueBool() || model.ThemeCode.ValueString() != "light" {
+		t.Errorf("expected branding and policy of org_acme, got %+v", model)
+	}
+
+	if requests["/api/v1/organizations"] != 1 || requests["/api/v1/organization"] != 1 {
+		t.Errorf("expected one list and one organization request, got %v", requests)
+	}
+}
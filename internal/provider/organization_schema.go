@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+	"github.com/nxt-fwd/kinde-go/api/roles"
+)
+
+// organizationThemeCodes are the color schemes an organization can use.
+var organizationThemeCodes = []string{"light", "dark", "user_preference"}
+
+// hexColorRegexp matches the short and long hex color notation. Colors are
+// normalized with normalizeHexColor before they are sent.
+var hexColorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// flattenOrganizationResource sets the attributes of the model that are
+// returned by the organizations client.
+func flattenOrganizationResource(organization *organizations.Organization, model *OrganizationResourceModel) {
+	model.Code = types.StringValue(organization.Code)
+	model.ID = types.StringValue(organization.Code)
+	model.Name = types.StringValue(organization.Name)
+	model.CreatedOn = types.StringValue(organization.CreatedOn.Format(time.RFC3339))
+	model.ThemeCode = types.StringValue(organization.ColorScheme)
+
+	// Handle optional values
+	model.Handle = types.StringPointerValue(organization.Handle)
+	model.ExternalID = types.StringPointerValue(organization.ExternalID)
+
+	model.BackgroundColor = flattenOrganizationColor(organization.BackgroundColor, model.BackgroundColor)
+	model.ButtonColor = flattenOrganizationColor(organization.ButtonColor, model.ButtonColor)
+	model.ButtonTextColor = flattenOrganizationColor(organization.ButtonTextColor, model.ButtonTextColor)
+	model.LinkColor = flattenOrganizationColor(organization.LinkColor, model.LinkColor)
+	model.BackgroundColorDark = flattenOrganizationColor(organization.BackgroundColorDark, model.BackgroundColorDark)
+	model.ButtonColorDark = flattenOrganizationColor(organization.ButtonColorDark, model.ButtonColorDark)
+	model.ButtonTextColorDark = flattenOrganizationColor(organization.ButtonTextColorDark, model.ButtonTextColorDark)
+	model.LinkColorDark = flattenOrganizationColor(organization.LinkColorDark, model.LinkColorDark)
+}
+
+// flattenOrganizationColor returns the color returned by the API. The prior
+// value is kept if it is the same color in a different notation, e.g. #05F
+// for #0055ff.
+func flattenOrganizationColor(color *organizations.Color, prior types.String) types.String {
+	if color == nil {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && normalizeHexColor(prior.ValueString()) == normalizeHexColor(color.Hex) {
+		return prior
+	}
+	return types.StringValue(color.Hex)
+}
+
+// expandOrganizationColor returns the normalized color of the plan, or an
+// empty string if it is not set.
+func expandOrganizationColor(color types.String) string {
+	if color.IsNull() || color.IsUnknown() {
+		return ""
+	}
+	return normalizeHexColor(color.ValueString())
+}
+
+// normalizeHexColor returns a hex color in the lowercase long notation the
+// API returns, e.g. #0055ff for #05F.
+func normalizeHexColor(color string) string {
+	color = strings.ToLower(color)
+	if len(color) == 4 && strings.HasPrefix(color, "#") {
+		return "#" + strings.Repeat(color[1:2], 2) + strings.Repeat(color[2:3], 2) + strings.Repeat(color[3:4], 2)
+	}
+	return color
+}
+
+// organizationPolicy holds the sign-up and membership settings of an
+// organization, which the organizations client only partially models.
+type organizationPolicy struct {
+	IsAllowRegistrations    *bool    `json:"is_allow_registrations,omitempty"`
+	IsAutoMembershipEnabled *bool    `json:"is_auto_membership_enabled,omitempty"`
+	AllowedDomains          []string `json:"allowed_domains,omitempty"`
+}
+
+// organizationPolicyParams is the update body for the sign-up and membership
+// settings. Unlike organizations.UpdateParams it can send false values. The
+// API reads auto-membership as is_auto_membership_enabled but updates it as
+// is_auto_join_domain_list, as the organizations client does.
+type organizationPolicyParams struct {
+	IsAllowRegistrations *bool     `json:"is_allow_registrations,omitempty"`
+	IsAutoJoinDomainList *bool     `json:"is_auto_join_domain_list,omitempty"`
+	AllowedDomains       *[]string `json:"allowed_domains,omitempty"`
+}
+
+// organizationCreateParams extends the client create params with the external
+// ID and branding, so the organization is complete after a single request, and
+// with the settings that can only be set when the organization is created.
+type organizationCreateParams struct {
+	organizations.CreateParams
+	ExternalID              string `json:"external_id,omitempty"`
+	BackgroundColor         string `json:"background_color,omitempty"`
+	ButtonColor             string `json:"button_color,omitempty"`
+	ButtonTextColor         string `json:"button_text_color,omitempty"`
+	LinkColor               string `json:"link_color,omitempty"`
+	BackgroundColorDark     string `json:"background_color_dark,omitempty"`
+	ButtonColorDark         string `json:"button_color_dark,omitempty"`
+	ButtonTextColorDark     string `json:"button_text_color_dark,omitempty"`
+	LinkColorDark           string `json:"link_color_dark,omitempty"`
+	ThemeCode               string `json:"theme_code,omitempty"`
+	IsCreateBillingCustomer bool   `json:"is_create_billing_customer,omitempty"`
+}
+
+func expandOrganizationCreateParams(plan OrganizationResourceModel) organizationCreateParams {
+	return organizationCreateParams{
+		CreateParams: organizations.CreateParams{
+			Name:   plan.Name.ValueString(),
+			Code:   plan.Code.ValueString(),
+			Handle: plan.Handle.ValueString(),
+		},
+		ExternalID:              plan.ExternalID.ValueString(),
+		BackgroundColor:         expandOrganizationColor(plan.BackgroundColor),
+		ButtonColor:             expandOrganizationColor(plan.ButtonColor),
+		ButtonTextColor:         expandOrganizationColor(plan.ButtonTextColor),
+		LinkColor:               expandOrganizationColor(plan.LinkColor),
+		BackgroundColorDark:     expandOrganizationColor(plan.BackgroundColorDark),
+		ButtonColorDark:         expandOrganizationColor(plan.ButtonColorDark),
+		ButtonTextColorDark:     expandOrganizationColor(plan.ButtonTextColorDark),
+		LinkColorDark:           expandOrganizationColor(plan.LinkColorDark),
+		ThemeCode:               plan.ThemeCode.ValueString(),
+		IsCreateBillingCustomer: plan.CreateBillingCustomer.ValueBool(),
+	}
+}
+
+// expandOrganizationPolicyParams builds the policy update body from the known
+// values of the plan. Returns nil if there is nothing to send.
+func expandOrganizationPolicyParams(ctx context.Context, plan OrganizationResourceModel) (*organizationPolicyParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var params organizationPolicyParams
+	set := false
+
+	if !plan.AllowRegistrations.IsNull() && !plan.AllowRegistrations.IsUnknown() {
+		params.IsAllowRegistrations = plan.AllowRegistrations.ValueBoolPointer()
+		set = true
+	}
+
+	if !plan.AutoMembershipEnabled.IsNull() && !plan.AutoMembershipEnabled.IsUnknown() {
+		params.IsAutoJoinDomainList = plan.AutoMembershipEnabled.ValueBoolPointer()
+		set = true
+	}
+
+	if !plan.AllowedDomains.IsNull() && !plan.AllowedDomains.IsUnknown() {
+		// An empty list is sent as is to clear the domains
+		domains := []string{}
+		diags.Append(plan.AllowedDomains.ElementsAs(ctx, &domains, false)...)
+		params.AllowedDomains = &domains
+		set = true
+	}
+
+	if !set {
+		return nil, diags
+	}
+
+	return &params, diags
+}
+
+// flattenOrganizationPolicy sets the sign-up and membership attributes of the
+// model from the API values.
+func flattenOrganizationPolicy(ctx context.Context, policy *organizationPolicy, model *OrganizationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.AllowRegistrations = types.BoolValue(policy.IsAllowRegistrations != nil && *policy.IsAllowRegistrations)
+	model.AutoMembershipEnabled = types.BoolValue(policy.IsAutoMembershipEnabled != nil && *policy.IsAutoMembershipEnabled)
+
+	domains := policy.AllowedDomains
+	if domains == nil {
+		domains = []string{}
+	}
+	model.AllowedDomains, diags = types.SetValueFrom(ctx, types.StringType, sortStringSlice(domains))
+
+	// The billing customer is only created once and is not returned by the API.
+	// Imported organizations are assumed to have none.
+	if model.CreateBillingCustomer.IsNull() || model.CreateBillingCustomer.IsUnknown() {
+		model.CreateBillingCustomer = types.BoolValue(false)
+	}
+
+	return diags
+}
+
+// createOrganization creates an organization including the settings that the
+// client create params do not support.
+func (r *OrganizationResource) createOrganization(ctx context.Context, params organizationCreateParams) (*organizations.Organization, error) {
+	request, err := r.client.NewRequest(ctx, http.MethodPost, "/api/v1/organization", nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var response organizations.CreateResponse
+	if err := r.client.DoRequest(request, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Organization, nil
+}
+
+// getOrganization retrieves an organization together with its sign-up and
+// membership settings, which the organizations client does not decode, in a
+// single request.
+func (r *OrganizationResource) getOrganization(ctx context.Context, code string) (*organizations.Organization, *organizationPolicy, error) {
+	query := url.Values{}
+	query.Set("code", code)
+
+	request, err := r.client.NewRequest(ctx, http.MethodGet, "/api/v1/organization", query, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var raw json.RawMessage
+	if err := r.client.DoRequest(request, &raw); err != nil {
+		return nil, nil, err
+	}
+
+	var organization organizations.Organization
+	if err := json.Unmarshal(raw, &organization); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse organization: %w", err)
+	}
+
+	var policy organizationPolicy
+	if err := json.Unmarshal(raw, &policy); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse organization: %w", err)
+	}
+
+	return &organization, &policy, nil
+}
+
+// updatePolicy updates the sign-up and membership settings of an organization.
+func (r *OrganizationResource) updatePolicy(ctx context.Context, code string, params organizationPolicyParams) error {
+	endpoint := fmt.Sprintf("/api/v1/organization/%s", code)
+	request, err := r.client.NewRequest(ctx, http.MethodPatch, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	return r.client.DoRequest(request, &response)
+}
+
+// defaultRoleParams is the role update body that marks a role as a default
+// role, which roles.UpdateParams cannot send. The API requires the name and
+// key on every role update.
+type defaultRoleParams struct {
+	Name          string `json:"name"`
+	Key           string `json:"key"`
+	Description   string `json:"description,omitempty"`
+	IsDefaultRole bool   `json:"is_default_role"`
+}
+
+// setDefaultRole marks a role as a default role or removes the mark.
+func (r *OrganizationResource) setDefaultRole(ctx context.Context, role roles.Role, isDefault bool) error {
+	params := defaultRoleParams{
+		Name:          role.Name,
+		Key:           role.Key,
+		Description:   role.Description,
+		IsDefaultRole: isDefault,
+	}
+
+	endpoint := fmt.Sprintf("/api/v1/roles/%s", role.ID)
+	request, err := r.client.NewRequest(ctx, http.MethodPatch, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	var response roles.UpdateResponse
+	return r.client.DoRequest(request, &response)
+}
+
+// updateDefaultRoles makes the roles in defaultRoles the default roles of the
+// environment. Nothing is changed if default_roles is not set.
+func (r *OrganizationResource) updateDefaultRoles(ctx context.Context, defaultRoles types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if defaultRoles.IsNull() || defaultRoles.IsUnknown() {
+		return diags
+	}
+
+	var roleIDs []string
+	diags.Append(defaultRoles.ElementsAs(ctx, &roleIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	allRoles, err := r.cache.Roles(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Roles",
+			fmt.Sprintf("Could not read roles: %s", err),
+		)
+		return diags
+	}
+
+	known := make(map[string]struct{}, len(allRoles))
+	for _, role := range allRoles {
+		known[role.ID] = struct{}{}
+	}
+	for _, roleID := range roleIDs {
+		if _, ok := known[roleID]; !ok {
+			diags.AddAttributeError(
+				path.Root("default_roles"),
+				"Unknown Default Role",
+				fmt.Sprintf("Role %s does not exist.", roleID),
+			)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	changed := false
+	for _, role := range allRoles {
+		isDefault := slices.Contains(roleIDs, role.ID)
+		if role.IsDefaultRole == isDefault {
+			continue
+		}
+
+		changed = true
+		if err := r.setDefaultRole(ctx, role, isDefault); err != nil {
+			diags.AddError(
+				"Error Updating Default Roles",
+				fmt.Sprintf("Could not update default role %s: %s", role.ID, err),
+			)
+			break
+		}
+	}
+
+	if changed {
+		r.cache.InvalidateRoles()
+	}
+	return diags
+}
+
+// readDefaultRoles sets default_roles to the current default roles of the
+// environment. Unset default roles are left unmanaged.
+func (r *OrganizationResource) readDefaultRoles(ctx context.Context, model *OrganizationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if model.DefaultRoles.IsNull() {
+		return diags
+	}
+
+	allRoles, err := r.cache.Roles(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Roles",
+			fmt.Sprintf("Could not read roles: %s", err),
+		)
+		return diags
+	}
+
+	roleIDs := []string{}
+	for _, role := range allRoles {
+		if role.IsDefaultRole {
+			roleIDs = append(roleIDs, role.ID)
+		}
+	}
+
+	model.DefaultRoles, diags = types.SetValueFrom(ctx, types.StringType, roleIDs)
+	return diags
+}
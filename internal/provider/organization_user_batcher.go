@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+)
+
+// organizationUserBatchWindow is how long membership and role writes for an
+// organization are collected before they are sent as a single request.
+// Terraform creates independent resources concurrently, so writes issued
+// within the window end up in the same request.
+const organizationUserBatchWindow = 250 * time.Millisecond
+
+// organizationUserBatcher coalesces concurrent membership and role writes
+// against the same organization. New members and their roles are sent as bulk
+// AddUsers requests, roles of existing members as bulk organization users
+// updates.
+type organizationUserBatcher struct {
+	client *organizations.Client
+	cache  *providerCache
+
+	mu      sync.Mutex
+	members *organizationUserBatches
+	roles   *organizationUserBatches
+}
+
+// organizationUserBatches are the batches of one kind of write, per
+// organization.
+type organizationUserBatches struct {
+	// open holds the batches that still take writes
+	open map[string]*organizationUserBatch
+	// sending holds the batches whose request has not completed yet
+	sending map[string][]*organizationUserBatch
+	send    func(ctx context.Context, orgCode string, users []organizations.AddUser) error
+}
+
+// organizationUserBatch is the set of pending writes for a single organization.
+type organizationUserBatch struct {
+	ctx     context.Context
+	userIDs []string
+	roleIDs map[string][]string
+	done    chan struct{}
+	errs    map[string]error
+}
+
+func newOrganizationUserBatcher(client *organizations.Client, cache *providerCache) *organizationUserBatcher {
+	b := &organizationUserBatcher{
+		client: client,
+		cache:  cache,
+	}
+	b.members = newOrganizationUserBatches(b.addUsers)
+	b.roles = newOrganizationUserBatches(b.updateUserRoles)
+	return b
+}
+
+func newOrganizationUserBatches(send func(context.Context, string, []organizations.AddUser) error) *organizationUserBatches {
+	return &organizationUserBatches{
+		open:    make(map[string]*organizationUserBatch),
+		sending: make(map[string][]*organizationUserBatch),
+		send:    send,
+	}
+}
+
+// AddUser adds a user with the given role IDs to an organization. It blocks
+// until the batch the user was added to has been sent.
+func (b *organizationUserBatcher) AddUser(ctx context.Context, orgCode, userID string, roleIDs []string) error {
+	b.mu.Lock()
+	batch := b.enqueue(ctx, b.members, orgCode)
+	batch.add(userID, roleIDs)
+	b.mu.Unlock()
+
+	return batch.wait(ctx, userID)
+}
+
+// AddUserRole adds a role to a member of an organization. If the membership
+// of the user is still pending, the role is assigned by the same AddUsers
+// request, and if that request is in flight, the role waits for it. Roles of
+// other members are coalesced into a bulk organization users update. It blocks
+// until the role has been sent.
+func (b *organizationUserBatcher) AddUserRole(ctx context.Context, orgCode, userID, roleID string) error {
+	b.mu.Lock()
+	if batch, ok := b.members.open[orgCode]; ok && batch.has(userID) {
+		batch.add(userID, []string{roleID})
+		b.mu.Unlock()
+		return batch.wait(ctx, userID)
+	}
+
+	// The user is not a member before its AddUsers request has completed
+	if i := slices.IndexFunc(b.members.sending[orgCode], func(batch *organizationUserBatch) bool { return batch.has(userID) }); i >= 0 {
+		batch := b.members.sending[orgCode][i]
+		b.mu.Unlock()
+		if err := batch.wait(ctx, userID); err != nil {
+			return err
+		}
+		b.mu.Lock()
+	}
+
+	batch := b.enqueue(ctx, b.roles, orgCode)
+	batch.add(userID, []string{roleID})
+	b.mu.Unlock()
+
+	return batch.wait(ctx, userID)
+}
+
+// enqueue returns the open batch of an organization, opening one that is
+// flushed after the batch window if there is none. It must be called with
+// b.mu held.
+func (b *organizationUserBatcher) enqueue(ctx context.Context, batches *organizationUserBatches, orgCode string) *organizationUserBatch {
+	batch, ok := batches.open[orgCode]
+	if !ok {
+		batch = &organizationUserBatch{
+			// The batch outlives the resource that opened it
+			ctx:     context.WithoutCancel(ctx),
+			roleIDs: make(map[string][]string),
+			done:    make(chan struct{}),
+			errs:    make(map[string]error),
+		}
+		batches.open[orgCode] = batch
+		time.AfterFunc(organizationUserBatchWindow, func() { b.flush(batches, orgCode, batch) })
+	}
+	return batch
+}
+
+// flush sends an open batch of an organization and wakes up its writers. The
+// batch is kept in sending until its writers have been woken up. If the batch
+// fails, each member is retried on its own, so one invalid member does not
+// fail the writes of the others.
+func (b *organizationUserBatcher) flush(batches *organizationUserBatches, orgCode string, batch *organizationUserBatch) {
+	b.mu.Lock()
+	if batches.open[orgCode] == batch {
+		delete(batches.open, orgCode)
+	}
+	batches.sending[orgCode] = append(batches.sending[orgCode], batch)
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		batches.sending[orgCode] = slices.DeleteFunc(batches.sending[orgCode], func(sent *organizationUserBatch) bool { return sent == batch })
+		if len(batches.sending[orgCode]) == 0 {
+			delete(batches.sending, orgCode)
+		}
+		close(batch.done)
+		b.mu.Unlock()
+	}()
+
+	tflog.Debug(batch.ctx, "Sending organization users in batch", map[string]interface{}{
+		"organization_code": orgCode,
+		"users":             len(batch.userIDs),
+	})
+
+	// Both requests take role keys rather than IDs
+	var roleKeys map[string]string
+	for _, roleIDs := range batch.roleIDs {
+		if len(roleIDs) > 0 {
+			var err error
+			roleKeys, err = b.cache.RoleKeys(batch.ctx)
+			if err != nil {
+				for _, userID := range batch.userIDs {
+					batch.errs[userID] = fmt.Errorf("could not read roles: %w", err)
+				}
+				return
+			}
+			break
+		}
+	}
+
+	users := make([]organizations.AddUser, 0, len(batch.userIDs))
+	for _, userID := range batch.userIDs {
+		user, err := expandOrganizationAddUser(userID, batch.roleIDs[userID], roleKeys)
+		if err != nil {
+			batch.errs[userID] = err
+			continue
+		}
+		users = append(users, user)
+	}
+
+	if len(users) == 0 {
+		return
+	}
+
+	err := batches.send(batch.ctx, orgCode, users)
+	if err == nil || len(users) == 1 {
+		for _, user := range users {
+			batch.errs[user.ID] = err
+		}
+		return
+	}
+
+	tflog.Debug(batch.ctx, "Retrying organization users one by one", map[string]interface{}{
+		"organization_code": orgCode,
+		"error":             err.Error(),
+	})
+
+	for _, user := range users {
+		batch.errs[user.ID] = batches.send(batch.ctx, orgCode, []organizations.AddUser{user})
+	}
+}
+
+// addUsers adds the users with their roles to an organization.
+func (b *organizationUserBatcher) addUsers(ctx context.Context, orgCode string, users []organizations.AddUser) error {
+	return b.client.AddUsers(ctx, orgCode, organizations.AddUsersParams{Users: users})
+}
+
+// updateUserRoles adds the roles to members of an organization with the bulk
+// organization users update.
+func (b *organizationUserBatcher) updateUserRoles(ctx context.Context, orgCode string, users []organizations.AddUser) error {
+	updates := make([]organizationUsersUpdate, 0, len(users))
+	for _, user := range users {
+		updates = append(updates, organizationUsersUpdate{ID: user.ID, Roles: user.Roles})
+	}
+	return updateOrganizationUsers(ctx, b.client, orgCode, updates)
+}
+
+// expandOrganizationAddUser returns the AddUsers entry of a user with the
+// given role IDs, which are mapped to role keys.
+func expandOrganizationAddUser(userID string, roleIDs []string, roleKeys map[string]string) (organizations.AddUser, error) {
+	user := organizations.AddUser{ID: userID}
+	for _, roleID := range roleIDs {
+		key, ok := roleKeys[roleID]
+		if !ok {
+			return user, fmt.Errorf("role ID %s does not exist", roleID)
+		}
+		user.Roles = append(user.Roles, key)
+	}
+	return user, nil
+}
+
+// has reports whether the batch holds writes for the given user.
+func (batch *organizationUserBatch) has(userID string) bool {
+	_, ok := batch.roleIDs[userID]
+	return ok
+}
+
+// add adds the role IDs of a user to the batch.
+func (batch *organizationUserBatch) add(userID string, roleIDs []string) {
+	if !batch.has(userID) {
+		batch.userIDs = append(batch.userIDs, userID)
+	}
+	batch.roleIDs[userID] = mergeRoleIDs(batch.roleIDs[userID], roleIDs)
+}
+
+// wait blocks until the batch has been sent or ctx is done and returns the
+// error of the given user.
+func (batch *organizationUserBatch) wait(ctx context.Context, userID string) error {
+	select {
+	case <-batch.done:
+		return batch.errs[userID]
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// mergeRoleIDs appends the role IDs that are not in existing yet.
+func mergeRoleIDs(existing, roleIDs []string) []string {
+	for _, roleID := range roleIDs {
+		if !slices.Contains(existing, roleID) {
+			existing = append(existing, roleID)
+		}
+	}
+	return existing
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+)
+
+func TestOrganizationUserBatcher_CoalescesWrites(t *testing.T) {
+	var mu sync.Mutex
+	var requests []organizations.AddUsersParams
+	var updates [][]organizationUsersUpdate
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/roles":
+			_, _ = w.Write([]byte(`{"roles":[{"id":"role_admin","key":"admin"},{"id":"role_viewer","key":"viewer"}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/organizations/org_test/users":
+			var params organizations.AddUsersParams
+			if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+				t.Errorf("could not decode request body: %s", err)
+			}
+			mu.Lock()
+			requests = append(requests, params)
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"code":"OK"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/organizations/org_test/users":
+			var params struct {
+				Users []organizationUsersUpdate `json:"users"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+				t.Errorf("could not decode request body: %s", err)
+			}
+			mu.Lock()
+			updates = append(updates, params.Users)
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"code":"OK"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	batcher := newOrganizationUserBatcher(client.Organizations, newProviderCache(client))
+
+	ctx := context.Background()
+	userIDs := []string{"kp_1", "kp_2", "kp_3", "kp_4"}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(userIDs)+1)
+	for i, userID := range userIDs {
+		var roleIDs []string
+		if i == 0 {
+			roleIDs = []string{"role_admin"}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- batcher.AddUser(ctx, "org_test", userID, roleIDs)
+		}()
+	}
+
+	// Role writes for a pending member join the same batch
+	time.Sleep(organizationUserBatchWindow / 5)
+	errs <- batcher.AddUserRole(ctx, "org_test", "kp_2", "role_viewer")
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 AddUsers request, got %d", len(requests))
+	}
+
+	roles := make(map[string][]string)
+	for _, user := range requests[0].Users {
+		roles[user.ID] = user.Roles
+	}
+	if len(roles) != len(userIDs) {
+		t.Fatalf("expected %d users in request, got %d", len(userIDs), len(roles))
+	}
+	if len(roles["kp_1"]) != 1 || roles["kp_1"][0] != "admin" {
+		t.Errorf("expected kp_1 to be added with role admin, got %v", roles["kp_1"])
+	}
+	if len(roles["kp_2"]) != 1 || roles["kp_2"][0] != "viewer" {
+		t.Errorf("expected kp_2 to be added with role viewer, got %v", roles["kp_2"])
+	}
+
+	// Role writes for existing members are coalesced into an update of their own
+	roleErrs := make(chan error, 2)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		roleErrs <- batcher.AddUserRole(ctx, "org_test", "kp_2", "role_admin")
+	}()
+	go func() {
+		defer wg.Done()
+		roleErrs <- batcher.AddUserRole(ctx, "org_test", "kp_3", "role_viewer")
+	}()
+	wg.Wait()
+	close(roleErrs)
+	for err := range roleErrs {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("expected no further AddUsers requests, got %d", len(requests))
+	}
+	if len(updates) != 1 {
+		t.Fatalf("expected 1 organization users update, got %d", len(updates))
+	}
+
+	roles = make(map[string][]string)
+	for _, user := range updates[0] {
+		if user.Operation != "" {
+			t.Errorf("expected no operation for %s, got %s", user.ID, user.Operation)
+		}
+		roles[user.ID] = user.Roles
+	}
+	if len(roles) != 2 || len(roles["kp_2"]) != 1 || roles["kp_2"][0] != "admin" || len(roles["kp_3"]) != 1 || roles["kp_3"][0] != "viewer" {
+		t.Errorf("unexpected roles in update: %v", roles)
+	}
+}
+
+func TestOrganizationUserBatcher_RoleWaitsForInFlightMember(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	received := make(chan struct{})
+	release := make(chan struct{})
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/roles":
+			_, _ = w.Write([]byte(`{"roles":[{"id":"role_admin","key":"admin"}]}`))
+		case r.URL.Path == "/api/v1/organizations/org_test/users":
+			mu.Lock()
+			requests = append(requests, r.Method)
+			mu.Unlock()
+
+			// Hold the membership request until the role has been written
+			if r.Method == http.MethodPost {
+				close(received)
+				<-release
+			}
+			_, _ = w.Write([]byte(`{"code":"OK"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	batcher := newOrganizationUserBatcher(client.Organizations, newProviderCache(client))
+	ctx := context.Background()
+
+	memberErr := make(chan error, 1)
+	go func() {
+		memberErr <- batcher.AddUser(ctx, "org_test", "kp_1", nil)
+	}()
+	<-received
+
+	roleErr := make(chan error, 1)
+	go func() {
+		roleErr <- batcher.AddUserRole(ctx, "org_test", "kp_1", "role_admin")
+	}()
+
+	select {
+	case err := <-roleErr:
+		t.Fatalf("expected role to wait for the membership request, got %v", err)
+	case <-time.After(2 * organizationUserBatchWindow):
+	}
+	close(release)
+
+	if err := <-memberErr; err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := <-roleErr; err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(requests) != 2 || requests[0] != http.MethodPost || requests[1] != http.MethodPatch {
+		t.Errorf("expected the role update after the membership request, got %v", requests)
+	}
+}
+
+func TestOrganizationUserBatcher_RetriesMembersIndividually(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/roles":
+			_, _ = w.Write([]byte(`{"roles":[{"id":"role_admin","key":"admin"}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/organizations/org_test/users":
+			var params organizations.AddUsersParams
+			if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+				t.Errorf("could not decode request body: %s", err)
+			}
+			mu.Lock()
+			requests++
+			mu.Unlock()
+
+			// The API rejects the whole request if one user does not exist
+			for _, user := range params.Users {
+				if user.ID == "kp_missing" {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"errors":[{"code":"USER_INVALID","message":"Invalid user"}]}`))
+					return
+				}
+			}
+			_, _ = w.Write([]byte(`{"code":"OK"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	batcher := newOrganizationUserBatcher(client.Organizations, newProviderCache(client))
+	ctx := context.Background()
+
+	members := map[string][]string{
+		"kp_1":        {"role_admin"},
+		"kp_2":        nil,
+		"kp_missing":  nil,
+		"kp_bad_role": {"role_missing"},
+	}
+
+	var wg sync.WaitGroup
+	var errsMu sync.Mutex
+	errs := make(map[string]error)
+	for userID, roleIDs := range members {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := batcher.AddUser(ctx, "org_test", userID, roleIDs)
+			errsMu.Lock()
+			errs[userID] = err
+			errsMu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if errs["kp_1"] != nil || errs["kp_2"] != nil {
+		t.Errorf("expected valid members to be added, got %v", errs)
+	}
+	if errs["kp_missing"] == nil {
+		t.Error("expected an error for the missing user")
+	}
+	if errs["kp_bad_role"] == nil {
+		t.Error("expected an error for the missing role")
+	}
+
+	// The batch of three users and a retry for each of them
+	if requests != 4 {
+		t.Errorf("expected 4 AddUsers requests, got %d", requests)
+	}
+}
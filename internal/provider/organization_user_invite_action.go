@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nxt-fwd/kinde-go"
+	"github.com/nxt-fwd/kinde-go/api/users"
+)
+
+var (
+	_ action.Action              = &OrganizationUserInviteAction{}
+	_ action.ActionWithConfigure = &OrganizationUserInviteAction{}
+)
+
+func NewOrganizationUserInviteAction() action.Action {
+	return &OrganizationUserInviteAction{}
+}
+
+type OrganizationUserInviteAction struct {
+	client  *kinde.Client
+	batcher *organizationUserBatcher
+}
+
+type OrganizationUserInviteActionModel struct {
+	OrganizationCode types.String `tfsdk:"organization_code"`
+	Email            types.String `tfsdk:"email"`
+	FirstName        types.String `tfsdk:"first_name"`
+	LastName         types.String `tfsdk:"last_name"`
+	Roles            types.List   `tfsdk:"roles"`
+}
+
+func (a *OrganizationUserInviteAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_user_invite"
+}
+
+func (a *OrganizationUserInviteAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Adds a user to an organization by email. A user with an email identity is created if no user with the email exists yet. Kinde does not send an invitation email, the user signs in with the email as usual.",
+
+		Attributes: map[string]schema.Attribute{
+			"organization_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the organization",
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the user",
+				Required:            true,
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "First name of the user, if the user is created",
+				Optional:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "Last name of the user, if the user is created",
+				Optional:            true,
+			},
+			"roles": schema.ListAttribute{
+				MarkdownDescription: "IDs of the roles to assign to the user in the organization",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (a *OrganizationUserInviteAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client.Client
+	a.batcher = client.batcher
+}
+
+func (a *OrganizationUserInviteAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config OrganizationUserInviteActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roleIDs []string
+	resp.Diagnostics.Append(config.Roles.ElementsAs(ctx, &roleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgCode := config.OrganizationCode.ValueString()
+	email := config.Email.ValueString()
+
+	userID, err := findUserID(ctx, a.client.Users, "email", email)
+	var notFound *userNotFoundError
+	switch {
+	case errors.As(err, &notFound):
+		tflog.Debug(ctx, "Creating invited user", map[string]interface{}{
+			"email": email,
+		})
+
+		user, err := a.client.Users.Create(ctx, users.CreateParams{
+			Profile: users.Profile{
+				GivenName:  config.FirstName.ValueString(),
+				FamilyName: config.LastName.ValueString(),
+			},
+			Identities: []users.Identity{{
+				Type:    string(users.IdentityTypeEmail),
+				Details: map[string]string{"email": email},
+			}},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating User",
+				fmt.Sprintf("Could not create user %s: %s", email, err),
+			)
+			return
+		}
+
+		userID = user.ID
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Created user %s for %s", userID, email)})
+	case err != nil:
+		resp.Diagnostics.AddError(
+			"Error Reading User",
+			fmt.Sprintf("Could not find user %s: %s", email, err),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Adding invited user to organization", map[string]interface{}{
+		"organization_code": orgCode,
+		"user_id":           userID,
+		"roles":             roleIDs,
+	})
+
+	if err := a.batcher.AddUser(ctx, orgCode, userID, roleIDs); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Adding User to Organization",
+			fmt.Sprintf("Could not add user %s to organization %s: %s", userID, orgCode, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Added user %s to organization %s", userID, orgCode)})
+}
@@ -7,19 +7,19 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/nxt-fwd/kinde-go"
 	"github.com/nxt-fwd/kinde-go/api/organizations"
 )
 
 var (
 	_ resource.Resource                = &OrganizationUserResource{}
 	_ resource.ResourceWithImportState = &OrganizationUserResource{}
+	_ resource.ResourceWithIdentity    = &OrganizationUserResource{}
 )
 
 func NewOrganizationUserResource() resource.Resource {
@@ -27,7 +27,22 @@ func NewOrganizationUserResource() resource.Resource {
 }
 
 type OrganizationUserResource struct {
-	client *organizations.Client
+	client  *organizations.Client
+	batcher *organizationUserBatcher
+}
+
+// OrganizationUserResourceIdentityModel identifies a membership by organization and
+// user.
+type OrganizationUserResourceIdentityModel struct {
+	OrganizationCode types.String `tfsdk:"organization_code"`
+	UserID           types.String `tfsdk:"user_id"`
+}
+
+func organizationUserIdentity(model OrganizationUserResourceModel) OrganizationUserResourceIdentityModel {
+	return OrganizationUserResourceIdentityModel{
+		OrganizationCode: model.OrganizationCode,
+		UserID:           model.UserID,
+	}
 }
 
 // Add custom plan modifier for empty lists
@@ -108,21 +123,37 @@ func (r *OrganizationUserResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+func (r *OrganizationUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization_code": identityschema.StringAttribute{
+				Description:       "The code of the organization.",
+				RequiredForImport: true,
+			},
+			"user_id": identityschema.StringAttribute{
+				Description:       "The ID of the user.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *OrganizationUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*kinde.Client)
+	client, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *kinde.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	r.client = client.Organizations
+	r.batcher = client.batcher
 }
 
 func (r *OrganizationUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -133,25 +164,6 @@ func (r *OrganizationUserResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	// First, add user to organization without roles
-	params := organizations.AddUsersParams{
-		Users: []organizations.AddUser{
-			{
-				ID: plan.UserID.ValueString(),
-			},
-		},
-	}
-
-	err := r.client.AddUsers(ctx, plan.OrganizationCode.ValueString(), params)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating Organization User",
-			fmt.Sprintf("Could not create organization user: %s", err),
-		)
-		return
-	}
-
-	// Then, if roles are specified, add them one by one
 	var roles []string
 	if !plan.Roles.IsNull() {
 		diags = plan.Roles.ElementsAs(ctx, &roles, false)
@@ -159,17 +171,17 @@ func (r *OrganizationUserResource) Create(ctx context.Context, req resource.Crea
 		if resp.Diagnostics.HasError() {
 			return
 		}
+	}
 
-		for _, roleID := range roles {
-			err := r.client.AddUserRole(ctx, plan.OrganizationCode.ValueString(), plan.UserID.ValueString(), roleID)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error Adding Role",
-					fmt.Sprintf("Could not add role %s: %s", roleID, err),
-				)
-				return
-			}
-		}
+	// Add user to organization with its roles, batched with concurrent writes
+	// to the same organization
+	err := r.batcher.AddUser(ctx, plan.OrganizationCode.ValueString(), plan.UserID.ValueString(), roles)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Organization User",
+			fmt.Sprintf("Could not create organization user: %s", err),
+		)
+		return
 	}
 
 	// Set ID
@@ -178,6 +190,7 @@ func (r *OrganizationUserResource) Create(ctx context.Context, req resource.Crea
 	// Set state
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, organizationUserIdentity(plan))...)
 }
 
 func (r *OrganizationUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -218,6 +231,7 @@ func (r *OrganizationUserResource) Read(ctx context.Context, req resource.ReadRe
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, organizationUserIdentity(state))...)
 }
 
 func (r *OrganizationUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -302,6 +316,7 @@ func (r *OrganizationUserResource) Update(ctx context.Context, req resource.Upda
 	// Set state
 	diags := resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, organizationUserIdentity(plan))...)
 }
 
 func (r *OrganizationUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -337,17 +352,5 @@ func (r *OrganizationUserResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *OrganizationUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: organization_code:user_id
-	idParts, err := splitID(req.ID, 2, "organization_code:user_id")
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			err.Error(),
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_code"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	importCompositeID(ctx, req, resp, "organization_code", "user_id")
 }
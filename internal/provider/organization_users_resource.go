@@ -0,0 +1,477 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+)
+
+var (
+	_ resource.Resource                = &OrganizationUsersResource{}
+	_ resource.ResourceWithImportState = &OrganizationUsersResource{}
+)
+
+// organizationUsersPageSize is the number of members requested per page.
+const organizationUsersPageSize = 500
+
+func NewOrganizationUsersResource() resource.Resource {
+	return &OrganizationUsersResource{}
+}
+
+type OrganizationUsersResource struct {
+	client *organizations.Client
+	cache  *providerCache
+}
+
+type OrganizationUsersResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationCode types.String `tfsdk:"organization_code"`
+	Users            types.Set    `tfsdk:"users"`
+}
+
+type OrganizationUsersMemberModel struct {
+	UserID types.String `tfsdk:"user_id"`
+	Roles  types.Set    `tfsdk:"roles"`
+}
+
+var organizationUsersMemberAttrTypes = map[string]attr.Type{
+	"user_id": types.StringType,
+	"roles":   types.SetType{ElemType: types.StringType},
+}
+
+// organizationMember is a user as returned by the organization users endpoint.
+type organizationMember struct {
+	ID    string   `json:"id"`
+	Email string   `json:"email"`
+	Roles []string `json:"roles"`
+}
+
+// organizationUsersUpdate is a single user of the bulk organization users
+// update, which the organizations client does not wrap.
+type organizationUsersUpdate struct {
+	ID        string   `json:"id"`
+	Operation string   `json:"operation,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+}
+
+func (r *OrganizationUsersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_users"
+}
+
+func (r *OrganizationUsersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritatively manages the members of a Kinde organization and their roles. Users added outside of Terraform are removed on the next apply. Do not use together with kinde_organization_user for the same organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The code of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_code": schema.StringAttribute{
+				Required:    true,
+				Description: "The code of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "All members of the organization.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the user.",
+						},
+						"roles": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The role IDs assigned to the user in the organization. Omit for members without roles.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationUsersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Organizations
+	r.cache = client.cache
+}
+
+func (r *OrganizationUsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationUsersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := expandOrganizationUsers(ctx, plan.Users)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The organization may already have members, e.g. its creator
+	current, err := r.getMemberRoles(ctx, plan.OrganizationCode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization Users",
+			fmt.Sprintf("Could not read users of organization %s: %s", plan.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+
+	if err := r.syncUsers(ctx, plan.OrganizationCode.ValueString(), current, desired); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Organization Users",
+			fmt.Sprintf("Could not update users of organization %s: %s", plan.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+
+	plan.ID = plan.OrganizationCode
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *OrganizationUsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationUsersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Report every member so users added outside of Terraform show up as drift
+	members, err := r.getMemberRoles(ctx, state.OrganizationCode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Organization Users",
+			fmt.Sprintf("Could not read users of organization %s: %s", state.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+
+	users, diags := flattenOrganizationUsers(ctx, members, state.Users)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = state.OrganizationCode
+	state.Users = users
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *OrganizationUsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OrganizationUsersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := expandOrganizationUsers(ctx, plan.Users)
+	resp.Diagnostics.Append(diags...)
+	current, diags := expandOrganizationUsers(ctx, state.Users)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncUsers(ctx, plan.OrganizationCode.ValueString(), current, desired); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Organization Users",
+			fmt.Sprintf("Could not update users of organization %s: %s", plan.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+
+	plan.ID = plan.OrganizationCode
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *OrganizationUsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationUsersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := expandOrganizationUsers(ctx, state.Users)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.syncUsers(ctx, state.OrganizationCode.ValueString(), current, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Removing Organization Users",
+			fmt.Sprintf("Could not remove users from organization %s: %s", state.OrganizationCode.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *OrganizationUsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: organization_code
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_code"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// expandOrganizationUsers converts the users set to a map of user ID to role IDs.
+func expandOrganizationUsers(ctx context.Context, users types.Set) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result := make(map[string][]string)
+
+	if users.IsNull() || users.IsUnknown() {
+		return result, diags
+	}
+
+	var members []OrganizationUsersMemberModel
+	diags.Append(users.ElementsAs(ctx, &members, false)...)
+	if diags.HasError() {
+		return result, diags
+	}
+
+	for _, member := range members {
+		var roles []string
+		if !member.Roles.IsNull() {
+			diags.Append(member.Roles.ElementsAs(ctx, &roles, false)...)
+		}
+		result[member.UserID.ValueString()] = roles
+	}
+
+	return result, diags
+}
+
+// flattenOrganizationUsers converts a map of user ID to role IDs to the users set.
+// Members without roles keep an empty roles set from prior, so `roles = []`
+// does not show a difference.
+func flattenOrganizationUsers(ctx context.Context, members map[string][]string, prior types.Set) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	objectType := types.ObjectType{AttrTypes: organizationUsersMemberAttrTypes}
+
+	emptyRoles := make(map[string]bool)
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorMembers []OrganizationUsersMemberModel
+		diags.Append(prior.ElementsAs(ctx, &priorMembers, false)...)
+		for _, member := range priorMembers {
+			if !member.Roles.IsNull() && !member.Roles.IsUnknown() && len(member.Roles.Elements()) == 0 {
+				emptyRoles[member.UserID.ValueString()] = true
+			}
+		}
+	}
+
+	userIDs := make([]string, 0, len(members))
+	for userID := range members {
+		userIDs = append(userIDs, userID)
+	}
+
+	users := make([]OrganizationUsersMemberModel, 0, len(members))
+	for _, userID := range sortStringSlice(userIDs) {
+		roles := types.SetNull(types.StringType)
+		if emptyRoles[userID] {
+			roles = types.SetValueMust(types.StringType, []attr.Value{})
+		}
+		if len(members[userID]) > 0 {
+			var d diag.Diagnostics
+			roles, d = types.SetValueFrom(ctx, types.StringType, sortStringSlice(members[userID]))
+			diags.Append(d...)
+		}
+
+		users = append(users, OrganizationUsersMemberModel{
+			UserID: types.StringValue(userID),
+			Roles:  roles,
+		})
+	}
+
+	set, d := types.SetValueFrom(ctx, objectType, users)
+	diags.Append(d...)
+	return set, diags
+}
+
+// getMembers retrieves all members of an organization, following pagination.
+func (r *OrganizationUsersResource) getMembers(ctx context.Context, orgCode string) ([]organizationMember, error) {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users", orgCode)
+	return collect(paginate[organizationMember](ctx, r.client, endpoint, "organization_users", organizationUsersPageSize))
+}
+
+// getMemberRoles retrieves all members of an organization with their role IDs.
+func (r *OrganizationUsersResource) getMemberRoles(ctx context.Context, orgCode string) (map[string][]string, error) {
+	members, err := r.getMembers(ctx, orgCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return memberRoleIDs(ctx, r.cache, members)
+}
+
+// memberRoleIDs maps the members of an organization to their role IDs. The
+// members endpoint returns role keys, which are mapped back to IDs through the
+// cached roles instead of reading the roles of every member.
+func memberRoleIDs(ctx context.Context, cache *providerCache, members []organizationMember) (map[string][]string, error) {
+	var roleIDs map[string]string
+	result := make(map[string][]string, len(members))
+	for _, member := range members {
+		if len(member.Roles) > 0 && roleIDs == nil {
+			roleKeys, err := cache.RoleKeys(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("could not read roles: %w", err)
+			}
+
+			roleIDs = make(map[string]string, len(roleKeys))
+			for id, key := range roleKeys {
+				roleIDs[key] = id
+			}
+		}
+
+		ids := make([]string, 0, len(member.Roles))
+		for _, key := range member.Roles {
+			id, ok := roleIDs[key]
+			if !ok {
+				return nil, fmt.Errorf("role key %s of user %s does not exist", key, member.ID)
+			}
+			ids = append(ids, id)
+		}
+		result[member.ID] = ids
+	}
+
+	return result, nil
+}
+
+// syncUsers removes the members in current that are not in desired and adds
+// the missing members with their roles, each in a single request. The roles
+// of the remaining members are then reconciled one by one.
+func (r *OrganizationUsersResource) syncUsers(ctx context.Context, orgCode string, current, desired map[string][]string) error {
+	var removedUsers []string
+	for userID := range current {
+		if _, ok := desired[userID]; !ok {
+			removedUsers = append(removedUsers, userID)
+		}
+	}
+
+	if len(removedUsers) > 0 {
+		if err := r.removeUsers(ctx, orgCode, sortStringSlice(removedUsers)); err != nil {
+			return fmt.Errorf("could not remove users: %w", err)
+		}
+	}
+
+	var newUsers []organizations.AddUser
+	var roleKeys map[string]string
+	for _, userID := range slices.Sorted(maps.Keys(desired)) {
+		if _, ok := current[userID]; ok {
+			continue
+		}
+
+		// The AddUsers request takes role keys rather than IDs
+		if len(desired[userID]) > 0 && roleKeys == nil {
+			var err error
+			roleKeys, err = r.cache.RoleKeys(ctx)
+			if err != nil {
+				return fmt.Errorf("could not read roles: %w", err)
+			}
+		}
+
+		user, err := expandOrganizationAddUser(userID, desired[userID], roleKeys)
+		if err != nil {
+			return err
+		}
+		newUsers = append(newUsers, user)
+	}
+
+	if len(newUsers) > 0 {
+		if err := r.client.AddUsers(ctx, orgCode, organizations.AddUsersParams{Users: newUsers}); err != nil {
+			return fmt.Errorf("could not add users: %w", err)
+		}
+	}
+
+	for userID, roles := range desired {
+		currentRoles, ok := current[userID]
+		if !ok {
+			continue
+		}
+
+		for _, roleID := range stringSliceDifference(currentRoles, roles) {
+			if err := r.client.RemoveUserRole(ctx, orgCode, userID, roleID); err != nil {
+				return fmt.Errorf("could not remove role %s from user %s: %w", roleID, userID, err)
+			}
+		}
+
+		for _, roleID := range stringSliceDifference(roles, currentRoles) {
+			if err := r.client.AddUserRole(ctx, orgCode, userID, roleID); err != nil {
+				return fmt.Errorf("could not add role %s to user %s: %w", roleID, userID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// removeUsers removes users from an organization with the bulk organization
+// users update.
+func (r *OrganizationUsersResource) removeUsers(ctx context.Context, orgCode string, userIDs []string) error {
+	users := make([]organizationUsersUpdate, 0, len(userIDs))
+	for _, userID := range userIDs {
+		users = append(users, organizationUsersUpdate{ID: userID, Operation: "delete"})
+	}
+	return updateOrganizationUsers(ctx, r.client, orgCode, users)
+}
+
+// updateOrganizationUsers sends a bulk organization users update.
+func updateOrganizationUsers(ctx context.Context, client *organizations.Client, orgCode string, users []organizationUsersUpdate) error {
+	params := struct {
+		Users []organizationUsersUpdate `json:"users"`
+	}{Users: users}
+
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/users", orgCode)
+	request, err := client.NewRequest(ctx, http.MethodPatch, endpoint, nil, params)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	return client.DoRequest(request, &response)
+}
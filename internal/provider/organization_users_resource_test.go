@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+)
+
+func TestAccOrganizationUsersResource(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationUsersResourceConfig(testID, `
+		{
+			user_id = kinde_user.first.id
+			roles   = [kinde_role.test.id]
+		},
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("kinde_organization_users.test", "id", "kinde_organization.test", "code"),
+					resource.TestCheckResourceAttr("kinde_organization_users.test", "users.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("kinde_organization_users.test", "users.*", map[string]string{
+						"roles.#": "1",
+					}),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccOrganizationUsersResourceConfig(testID, `
+		{
+			user_id = kinde_user.first.id
+		},
+		{
+			user_id = kinde_user.second.id
+			roles   = [kinde_role.test.id]
+		},
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_organization_users.test", "users.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kinde_organization_users.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrganizationUsersResourceConfig(name, users string) string {
+	return fmt.Sprintf(`
+resource "kinde_organization" "test" {
+	name = %[1]q
+}
+
+resource "kinde_role" "test" {
+	name = "%[1]s-role"
+	key  = "%[1]s_role"
+}
+
+resource "kinde_user" "first" {
+	first_name = "First"
+	last_name  = "User"
+
+	identities = [
+		{
+			type  = "email"
+			value = "%[1]s-first@example.com"
+		},
+	]
+}
+
+resource "kinde_user" "second" {
+	first_name = "Second"
+	last_name  = "User"
+
+	identities = [
+		{
+			type  = "email"
+			value = "%[1]s-second@example.com"
+		},
+	]
+}
+
+resource "kinde_organization_users" "test" {
+	organization_code = kinde_organization.test.code
+	users = [%[2]s	]
+}
+`, name, users)
+}
+
+func TestOrganizationUsersResource_SyncUsers(t *testing.T) {
+	var added organizations.AddUsersParams
+	var removed struct {
+		Users []organizationUsersUpdate `json:"users"`
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/roles":
+			_, _ = w.Write([]byte(`{"roles":[{"id":"role_admin","key":"admin"},{"id":"role_viewer","key":"viewer"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/organizations/org_test/users":
+			_, _ = w.Write([]byte(`{"organization_users":[{"id":"kp_alice","roles":["admin"]},{"id":"kp_bob","roles":[]},{"id":"kp_carol"}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/organizations/org_test/users":
+			if err := json.NewDecoder(r.Body).Decode(&added); err != nil {
+				t.Errorf("could not decode request body: %s", err)
+			}
+			_, _ = w.Write([]byte(`{"code":"OK"}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/organizations/org_test/users":
+			if err := json.NewDecoder(r.Body).Decode(&removed); err != nil {
+				t.Errorf("could not decode request body: %s", err)
+			}
+			_, _ = w.Write([]byte(`{"code":"OK"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	r := &OrganizationUsersResource{client: client.Organizations, cache: newProviderCache(client)}
+	ctx := context.Background()
+
+	current, err := r.getMemberRoles(ctx, "org_test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if roles := current["kp_alice"]; len(roles) != 1 || roles[0] != "role_admin" {
+		t.Errorf("expected kp_alice to have role role_admin, got %v", current)
+	}
+
+	desired := map[string][]string{
+		"kp_alice": {"role_admin"},
+		"kp_dave":  {"role_admin", "role_viewer"},
+		"kp_erin":  nil,
+	}
+	if err := r.syncUsers(ctx, "org_test", current, desired); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(added.Users) != 2 || added.Users[0].ID != "kp_dave" || len(added.Users[0].Roles) != 2 || added.Users[0].Roles[1] != "viewer" || added.Users[1].ID != "kp_erin" {
+		t.Errorf("expected kp_dave with roles admin and viewer and kp_erin to be added, got %+v", added.Users)
+	}
+	if len(removed.Users) != 2 || removed.Users[0].ID != "kp_bob" || removed.Users[1].ID != "kp_carol" || removed.Users[0].Operation != "delete" {
+		t.Errorf("expected kp_bob and kp_carol to be removed, got %+v", removed.Users)
+	}
+}
+
+func TestFlattenOrganizationUsers_EmptyRoles(t *testing.T) {
+	ctx := context.Background()
+	objectType := types.ObjectType{AttrTypes: organizationUsersMemberAttrTypes}
+	prior := types.SetValueMust(objectType, []attr.Value{
+		types.ObjectValueMust(organizationUsersMemberAttrTypes, map[string]attr.Value{
+			"user_id": types.StringValue("kp_alice"),
+			"roles":   types.SetValueMust(types.StringType, []attr.Value{}),
+		}),
+		types.ObjectValueMust(organizationUsersMemberAttrTypes, map[string]attr.Value{
+			"user_id": types.StringValue("kp_bob"),
+			"roles":   types.SetNull(types.StringType),
+		}),
+	})
+
+	users, diags := flattenOrganizationUsers(ctx, map[string][]string{"kp_alice": nil, "kp_bob": nil}, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !users.Equal(prior) {
+		t.Errorf("expected %s, got %s", prior, users)
+	}
+}
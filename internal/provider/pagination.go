@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/nxt-fwd/kinde-go/api/apis"
+	"github.com/nxt-fwd/kinde-go/api/connections"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+	"github.com/nxt-fwd/kinde-go/api/permissions"
+	"github.com/nxt-fwd/kinde-go/api/roles"
+	"github.com/nxt-fwd/kinde-go/api/users"
+)
+
+// listPageSize is the number of items requested per page when enumerating a
+// collection.
+const listPageSize = 100
+
+// pageRequester is the request interface of the kinde-go API clients.
+type pageRequester interface {
+	NewRequest(ctx context.Context, method, path string, query url.Values, payload any) (*http.Request, error)
+	DoRequest(req *http.Request, result any) error
+}
+
+// paginate returns an iterator over all items of a list endpoint. Pages are
+// requested as the items of the previous page are consumed, and the items of
+// a page are read from the given JSON field of the response. Iteration ends
+// after the first error.
+func paginate[T any](ctx context.Context, client pageRequester, endpoint, field string, pageSize int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		nextToken := ""
+		for {
+			query := url.Values{}
+			query.Set("page_size", fmt.Sprint(pageSize))
+			if nextToken != "" {
+				query.Set("next_token", nextToken)
+			}
+
+			items, token, err := getPage[T](ctx, client, endpoint, field, query)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			// The last page may still carry a token, so an empty page also ends the listing
+			if token == "" || token == nextToken || len(items) == 0 {
+				return
+			}
+			nextToken = token
+		}
+	}
+}
+
+// getPage requests a single page of a list endpoint and returns its items and
+// the token of the next page.
+func getPage[T any](ctx context.Context, client pageRequester, endpoint, field string, query url.Values) ([]T, string, error) {
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, query, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var response map[string]json.RawMessage
+	if err := client.DoRequest(request, &response); err != nil {
+		return nil, "", err
+	}
+
+	var items []T
+	if raw, ok := response[field]; ok {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, "", fmt.Errorf("could not decode %s: %w", field, err)
+		}
+	}
+
+	var nextToken string
+	if raw, ok := response["next_token"]; ok {
+		if err := json.Unmarshal(raw, &nextToken); err != nil {
+			return nil, "", fmt.Errorf("could not decode next_token: %w", err)
+		}
+	}
+
+	return items, nextToken, nil
+}
+
+// collect returns all items of an iterator, or the first error.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// listAllPermissions iterates over all permissions.
+func listAllPermissions(ctx context.Context, client *permissions.Client) iter.Seq2[permissions.Permission, error] {
+	return paginate[permissions.Permission](ctx, client, "/api/v1/permissions", "permissions", listPageSize)
+}
+
+// listAllRoles iterates over all roles. The permissions of the roles are not included.
+func listAllRoles(ctx context.Context, client *roles.Client) iter.Seq2[roles.Role, error] {
+	return paginate[roles.Role](ctx, client, "/api/v1/roles", "roles", listPageSize)
+}
+
+// listAllUsers iterates over all users.
+func listAllUsers(ctx context.Context, client *users.Client) iter.Seq2[users.User, error] {
+	return paginate[users.User](ctx, client, "/api/v1/users", "users", listPageSize)
+}
+
+// listAllOrganizations iterates over all organizations. Branding is not included.
+func listAllOrganizations(ctx context.Context, client *organizations.Client) iter.Seq2[organizations.Organization, error] {
+	return paginate[organizations.Organization](ctx, client, "/api/v1/organizations", "organizations", listPageSize)
+}
+
+// listAllAPIs iterates over all APIs.
+func listAllAPIs(ctx context.Context, client *apis.Client) iter.Seq2[apis.API, error] {
+	return paginate[apis.API](ctx, client, "/api/v1/apis", "apis", listPageSize)
+}
+
+// listAllConnections iterates over all connections. The connections endpoint
+// pages with starting_after instead of next_token, and the connections client
+// does not expose its requests, so they are sent with another API client.
+func listAllConnections(ctx context.Context, client pageRequester) iter.Seq2[connections.Connection, error] {
+	return func(yield func(connections.Connection, error) bool) {
+		startingAfter := ""
+		for {
+			query := url.Values{}
+			query.Set("page_size", fmt.Sprint(listPageSize))
+			if startingAfter != "" {
+				query.Set("starting_after", startingAfter)
+			}
+
+			var response struct {
+				Connections []connections.Connection `json:"connections"`
+				HasMore     bool                     `json:"has_more"`
+			}
+			request, err := client.NewRequest(ctx, http.MethodGet, "/api/v1/connections", query, nil)
+			if err == nil {
+				err = client.DoRequest(request, &response)
+			}
+			if err != nil {
+				yield(connections.Connection{}, err)
+				return
+			}
+
+			for _, conn := range response.Connections {
+				if !yield(conn, nil) {
+					return
+				}
+			}
+
+			if !response.HasMore || len(response.Connections) == 0 {
+				return
+			}
+			startingAfter = response.Connections[len(response.Connections)-1].ID
+		}
+	}
+}
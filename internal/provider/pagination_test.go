@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPaginate_FollowsNextToken(t *testing.T) {
+	var pages []string
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/users":
+			if got := r.URL.Query().Get("page_size"); got != fmt.Sprint(listPageSize) {
+				t.Errorf("expected page_size %d, got %s", listPageSize, got)
+			}
+
+			token := r.URL.Query().Get("next_token")
+			pages = append(pages, token)
+			switch token {
+			case "":
+				_, _ = w.Write([]byte(`{"users":[{"id":"kp_1"},{"id":"kp_2"}],"next_token":"page_2"}`))
+			case "page_2":
+				_, _ = w.Write([]byte(`{"users":[{"id":"kp_3"}],"next_token":"page_3"}`))
+			default:
+				// The last page still carries a token
+				_, _ = w.Write([]byte(`{"users":[],"next_token":"page_4"}`))
+			}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	ctx := context.Background()
+
+	allUsers, err := collect(listAllUsers(ctx, client.Users))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(allUsers) != 3 || allUsers[2].ID != "kp_3" {
+		t.Errorf("expected users kp_1 to kp_3, got %v", allUsers)
+	}
+	if len(pages) != 3 {
+		t.Errorf("expected 3 page requests, got %v", pages)
+	}
+
+	// Stopping early does not request further pages
+	pages = nil
+	for user, err := range listAllUsers(ctx, client.Users) {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if user.ID == "kp_1" {
+			break
+		}
+	}
+	if len(pages) != 1 {
+		t.Errorf("expected 1 page request, got %v", pages)
+	}
+}
+
+func TestListAllConnections_FollowsStartingAfter(t *testing.T) {
+	var cursors []string
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/connections":
+			cursor := r.URL.Query().Get("starting_after")
+			cursors = append(cursors, cursor)
+			if cursor == "" {
+				_, _ = w.Write([]byte(`{"connections":[{"id":"conn_1"},{"id":"conn_2"}],"has_more":true}`))
+			} else {
+				_, _ = w.Write([]byte(`{"connections":[{"id":"conn_3"}],"has_more":false}`))
+			}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+
+	conns, err := collect(listAllConnections(context.Background(), client.Organizations))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(conns) != 3 || conns[2].ID != "conn_3" {
+		t.Errorf("expected connections conn_1 to conn_3, got %v", conns)
+	}
+	if len(cursors) != 2 || cursors[1] != "conn_2" {
+		t.Errorf("expected the second page to start after conn_2, got %v", cursors)
+	}
+}
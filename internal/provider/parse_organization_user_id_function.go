@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseOrganizationUserIDFunction{}
+
+// organizationUserIDAttrTypes are the parts of a kinde_organization_user ID.
+var organizationUserIDAttrTypes = map[string]attr.Type{
+	"organization_code": types.StringType,
+	"user_id":           types.StringType,
+}
+
+func NewParseOrganizationUserIDFunction() function.Function {
+	return &ParseOrganizationUserIDFunction{}
+}
+
+type ParseOrganizationUserIDFunction struct{}
+
+func (f *ParseOrganizationUserIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_organization_user_id"
+}
+
+func (f *ParseOrganizationUserIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse the ID of a kinde_organization_user",
+		MarkdownDescription: "Splits the ID of a `kinde_organization_user` in the format `organization_code:user_id` into an object with the attributes `organization_code` and `user_id`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "ID of the organization membership",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: organizationUserIDAttrTypes,
+		},
+	}
+}
+
+func (f *ParseOrganizationUserIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	idParts, err := splitID(id, 2, "organization_code:user_id")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, OrganizationUserResourceIdentityModel{
+		OrganizationCode: types.StringValue(idParts[0]),
+		UserID:           types.StringValue(idParts[1]),
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseUserRoleIDFunction{}
+
+// userRoleIDAttrTypes are the parts of a kinde_user_role ID.
+var userRoleIDAttrTypes = map[string]attr.Type{
+	"organization_code": types.StringType,
+	"user_id":           types.StringType,
+	"role_id":           types.StringType,
+}
+
+func NewParseUserRoleIDFunction() function.Function {
+	return &ParseUserRoleIDFunction{}
+}
+
+type ParseUserRoleIDFunction struct{}
+
+func (f *ParseUserRoleIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_user_role_id"
+}
+
+func (f *ParseUserRoleIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse the ID of a kinde_user_role",
+		MarkdownDescription: "Splits the ID of a `kinde_user_role` in the format `organization_code:user_id:role_id` into an object with the attributes `organization_code`, `user_id` and `role_id`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "ID of the user role assignment",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: userRoleIDAttrTypes,
+		},
+	}
+}
+
+func (f *ParseUserRoleIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	idParts, err := splitID(id, 3, "organization_code:user_id:role_id")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, UserRoleResourceIdentityModel{
+		OrganizationCode: types.StringValue(idParts[0]),
+		UserID:           types.StringValue(idParts[1]),
+		RoleID:           types.StringValue(idParts[2]),
+	})
+}
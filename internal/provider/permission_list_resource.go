@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/nxt-fwd/kinde-go/api/permissions"
+)
+
+var _ list.ListResourceWithConfigure = &PermissionResource{}
+
+func NewPermissionListResource() list.ListResource {
+	return &PermissionResource{}
+}
+
+func (r *PermissionResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceConfigSchema("Lists all Kinde permissions.")
+}
+
+func (r *PermissionResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = listResults(ctx, req, "permissions", listAllPermissions(ctx, r.client), func(ctx context.Context, permission permissions.Permission, result *list.ListResult) {
+		state := flattenPermissionResource(&permission)
+		result.DisplayName = permission.Name
+		setListResult(ctx, req, result, state.ID, func() (PermissionResourceModel, diag.Diagnostics) {
+			return state, nil
+		})
+	})
+}
@@ -6,18 +6,20 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/nxt-fwd/kinde-go"
 	"github.com/nxt-fwd/kinde-go/api/permissions"
 )
 
 var (
 	_ resource.Resource                = &PermissionResource{}
 	_ resource.ResourceWithImportState = &PermissionResource{}
+	_ resource.ResourceWithIdentity    = &PermissionResource{}
 )
 
 func NewPermissionResource() resource.Resource {
@@ -26,6 +28,7 @@ func NewPermissionResource() resource.Resource {
 
 type PermissionResource struct {
 	client *permissions.Client
+	cache  *providerCache
 }
 
 func (r *PermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,21 +61,26 @@ func (r *PermissionResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (r *PermissionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIDIdentitySchema("ID of the permission")
+}
+
 func (r *PermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*kinde.Client)
+	client, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *kinde.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	r.client = client.Permissions
+	r.cache = client.cache
 }
 
 func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -93,6 +101,8 @@ func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	r.cache.InvalidatePermissions()
+
 	plan.ID = types.StringValue(permission.ID)
 
 	// After creation, search for the permission to get its full details
@@ -113,6 +123,7 @@ func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequ
 	state := flattenPermissionResource(permission)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 }
 
 func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -123,10 +134,9 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// List all permissions with a larger page size
-	perms, err := r.client.List(ctx, permissions.ListParams{
-		PageSize: 100, // Use a larger page size to reduce pagination
-	})
+	// Permissions are matched against the cached list, so reading many
+	// permissions costs a single request
+	perms, err := r.cache.Permissions(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Permission",
@@ -142,6 +152,7 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 				state = flattenPermissionResource(&p)
 				diags = resp.State.Set(ctx, &state)
 				resp.Diagnostics.Append(diags...)
+				resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 				return
 			}
 		}
@@ -154,6 +165,7 @@ func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest,
 				state = flattenPermissionResource(&p)
 				diags = resp.State.Set(ctx, &state)
 				resp.Diagnostics.Append(diags...)
+				resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 				return
 			}
 		}
@@ -181,6 +193,8 @@ func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	r.cache.InvalidatePermissions()
+
 	// After update, search for the permission to get its latest state
 	searchParams := permissions.SearchParams{
 		Name: plan.Name.ValueString(),
@@ -199,6 +213,7 @@ func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequ
 	state := flattenPermissionResource(permission)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 }
 
 func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -216,13 +231,18 @@ func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequ
 		)
 		return
 	}
+
+	r.cache.InvalidatePermissions()
 }
 
 func (r *PermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// List all permissions with a larger page size to reduce API calls
-	perms, err := r.client.List(ctx, permissions.ListParams{
-		PageSize: 100, // Use a larger page size to reduce pagination
-	})
+	id, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	perms, err := r.cache.Permissions(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Permission",
@@ -231,17 +251,27 @@ func (r *PermissionResource) ImportState(ctx context.Context, req resource.Impor
 		return
 	}
 
-	// Find the permission by ID
+	// Find the permission by key if requested, otherwise by ID
+	key, byKey := strings.CutPrefix(id, importKeyPrefix)
 	for _, p := range perms {
-		if p.ID == req.ID {
+		if (byKey && p.Key == key) || (!byKey && p.ID == id) {
 			state := flattenPermissionResource(&p)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 			return
 		}
 	}
 
+	if byKey {
+		resp.Diagnostics.AddError(
+			"Error Reading Permission",
+			fmt.Sprintf("Could not find permission with key %s", key),
+		)
+		return
+	}
+
 	resp.Diagnostics.AddError(
 		"Error Reading Permission",
-		fmt.Sprintf("Could not find permission with ID %s", req.ID),
+		fmt.Sprintf("Could not find permission with ID %s", id),
 	)
 }
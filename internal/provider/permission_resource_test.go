@@ -31,6 +31,13 @@ func TestAccPermissionResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by key testing
+			{
+				ResourceName:      "kinde_permission.test",
+				ImportState:       true,
+				ImportStateId:     "key:test_permission",
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
 				Config: testAccPermissionResourceConfig("updated-permission", "updated_permission", "Updated test permission description"),
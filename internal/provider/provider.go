@@ -7,7 +7,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,7 +21,13 @@ import (
 )
 
 // Ensure KindeProvider satisfies various provider interfaces.
-var _ provider.Provider = &KindeProvider{}
+var (
+	_ provider.Provider                       = &KindeProvider{}
+	_ provider.ProviderWithEphemeralResources = &KindeProvider{}
+	_ provider.ProviderWithListResources      = &KindeProvider{}
+	_ provider.ProviderWithFunctions          = &KindeProvider{}
+	_ provider.ProviderWithActions            = &KindeProvider{}
+)
 
 // KindeProvider defines the provider implementation.
 type KindeProvider struct {
@@ -71,6 +81,50 @@ func (p *KindeProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	opts := clientOptions(data)
+
+	client, err := newClient(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Kinde Client",
+			fmt.Sprintf("Failed to authenticate with Kinde API: %v\n"+
+				"Please verify your domain, client_id, client_secret, and audience are correct.", err),
+		)
+		return
+	}
+
+	providerData := newProviderData(&client)
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+	resp.ListResourceData = providerData
+	resp.ActionData = providerData
+	// Ephemeral resources authenticate with their own credentials and only
+	// need the resolved client options, e.g. the domain.
+	resp.EphemeralResourceData = opts
+}
+
+// providerData is the data resources, data sources, list resources and actions
+// are configured with. The cache and the batcher are shared by all of them and
+// live as long as the provider instance.
+type providerData struct {
+	*kinde.Client
+
+	cache   *providerCache
+	batcher *organizationUserBatcher
+}
+
+func newProviderData(client *kinde.Client) *providerData {
+	cache := newProviderCache(client)
+	return &providerData{
+		Client:  client,
+		cache:   cache,
+		batcher: newOrganizationUserBatcher(client.Organizations, cache),
+	}
+}
+
+// clientOptions returns the client options of the provider configuration.
+// Attributes that are not set fall back to the KINDE_* environment variables.
+func clientOptions(data KindeProviderModel) *kinde.ClientOptions {
 	opts := kinde.NewClientOptions()
 
 	if !data.Domain.IsNull() && !data.Domain.IsUnknown() {
@@ -89,21 +143,16 @@ func (p *KindeProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		opts.WithClientSecret(data.ClientSecret.ValueString())
 	}
 
+	return opts
+}
+
+// newClient creates a Kinde client and validates its credentials with a test
+// API call.
+func newClient(ctx context.Context, opts *kinde.ClientOptions) (kinde.Client, error) {
 	client := kinde.New(ctx, opts)
 
-	// Validate credentials by making a test API call
 	_, err := client.Users.List(ctx, users.ListParams{PageSize: 1})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Create Kinde Client",
-			fmt.Sprintf("Failed to authenticate with Kinde API: %v\n"+
-				"Please verify your domain, client_id, client_secret, and audience are correct.", err),
-		)
-		return
-	}
-
-	resp.DataSourceData = &client
-	resp.ResourceData = &client
+	return client, err
 }
 
 func (p *KindeProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -111,11 +160,16 @@ func (p *KindeProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewAPIResource,
 		NewApplicationResource,
 		NewApplicationConnectionResource,
+		NewApplicationConnectionsResource,
 		NewConnectionResource,
 		NewOrganizationResource,
+		NewOrganizationConnectionResource,
+		NewOrganizationLogoResource,
 		NewOrganizationUserResource,
+		NewOrganizationUsersResource,
 		NewRoleResource,
 		NewUserResource,
+		NewUserIdentityResource,
 		NewPermissionResource,
 		NewUserRoleResource,
 	}
@@ -129,6 +183,41 @@ func (p *KindeProvider) DataSources(ctx context.Context) []func() datasource.Dat
 	}
 }
 
+func (p *KindeProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewM2MTokenEphemeralResource,
+	}
+}
+
+func (p *KindeProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewApplicationListResource,
+		NewConnectionListResource,
+		NewOrganizationListResource,
+		NewPermissionListResource,
+		NewRoleListResource,
+		NewUserListResource,
+	}
+}
+
+func (p *KindeProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewBuildAuthorizeURLFunction,
+		NewBuildIssuerURLFunction,
+		NewBuildJWKSURLFunction,
+		NewParseOrganizationUserIDFunction,
+		NewParseUserRoleIDFunction,
+	}
+}
+
+func (p *KindeProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewOrganizationUserInviteAction,
+		NewUserRefreshClaimsAction,
+		NewUserSuspendAction,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &KindeProvider{
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nxt-fwd/kinde-go"
+	"github.com/nxt-fwd/kinde-go/api/connections"
+	"github.com/nxt-fwd/kinde-go/api/organizations"
+	"github.com/nxt-fwd/kinde-go/api/permissions"
+	"github.com/nxt-fwd/kinde-go/api/roles"
+)
+
+// providerCache keeps the result of list endpoints for the lifetime of a
+// provider instance. Terraform reads every resource during refresh, so
+// resolving reads against a cached list costs one request per resource type
+// instead of one per resource. Resources invalidate the list of their type
+// whenever they write.
+type providerCache struct {
+	client *kinde.Client
+
+	permissions   cachedList[permissions.Permission]
+	roles         cachedList[roles.Role]
+	connections   cachedList[connections.Connection]
+	organizations cachedList[organizations.Organization]
+}
+
+// cachedList is a lazily loaded list. Concurrent callers wait for a single load.
+type cachedList[T any] struct {
+	mu     sync.Mutex
+	items  []T
+	loaded bool
+}
+
+func newProviderCache(client *kinde.Client) *providerCache {
+	return &providerCache{client: client}
+}
+
+// Permissions returns all permissions.
+func (c *providerCache) Permissions(ctx context.Context) ([]permissions.Permission, error) {
+	return c.permissions.get(ctx, func(ctx context.Context) ([]permissions.Permission, error) {
+		return collect(listAllPermissions(ctx, c.client.Permissions))
+	})
+}
+
+// Roles returns all roles. The permissions of the roles are not included.
+func (c *providerCache) Roles(ctx context.Context) ([]roles.Role, error) {
+	return c.roles.get(ctx, func(ctx context.Context) ([]roles.Role, error) {
+		return collect(listAllRoles(ctx, c.client.Roles))
+	})
+}
+
+// RoleKeys returns a map of role ID to role key. Organization membership
+// endpoints take and return role keys rather than IDs.
+func (c *providerCache) RoleKeys(ctx context.Context) (map[string]string, error) {
+	allRoles, err := c.Roles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]string, len(allRoles))
+	for _, role := range allRoles {
+		keys[role.ID] = role.Key
+	}
+	return keys, nil
+}
+
+// Connections returns all connections.
+func (c *providerCache) Connections(ctx context.Context) ([]connections.Connection, error) {
+	return c.connections.get(ctx, func(ctx context.Context) ([]connections.Connection, error) {
+		return collect(listAllConnections(ctx, c.client.Organizations))
+	})
+}
+
+// Organizations returns all organizations. Branding and policy are not
+// included.
+func (c *providerCache) Organizations(ctx context.Context) ([]organizations.Organization, error) {
+	return c.organizations.get(ctx, func(ctx context.Context) ([]organizations.Organization, error) {
+		return collect(listAllOrganizations(ctx, c.client.Organizations))
+	})
+}
+
+// InvalidatePermissions drops the cached permissions.
+func (c *providerCache) InvalidatePermissions() {
+	c.permissions.invalidate()
+}
+
+// InvalidateRoles drops the cached roles.
+func (c *providerCache) InvalidateRoles() {
+	c.roles.invalidate()
+}
+
+// InvalidateConnections drops the cached connections.
+func (c *providerCache) InvalidateConnections() {
+	c.connections.invalidate()
+}
+
+// InvalidateOrganizations drops the cached organizations.
+func (c *providerCache) InvalidateOrganizations() {
+	c.organizations.invalidate()
+}
+
+// get returns the cached items, loading them first if needed. Failed loads
+// are not cached.
+func (l *cachedList[T]) get(ctx context.Context, load func(context.Context) ([]T, error)) ([]T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.loaded {
+		return l.items, nil
+	}
+
+	items, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l.items = items
+	l.loaded = true
+	return items, nil
+}
+
+func (l *cachedList[T]) invalidate() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.items = nil
+	l.loaded = false
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestProviderCache_ListsOncePerInvalidation(t *testing.T) {
+	var listRequests atomic.Int32
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/permissions":
+			listRequests.Add(1)
+			_, _ = w.Write([]byte(`{"permissions":[{"id":"perm_read","key":"read"},{"id":"perm_write","key":"write"}]}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	cache := newProviderCache(client)
+
+	ctx := context.Background()
+
+	// Concurrent reads share a single request
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			perms, err := cache.Permissions(ctx)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			if len(perms) != 2 {
+				t.Errorf("expected 2 permissions, got %d", len(perms))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := listRequests.Load(); got != 1 {
+		t.Fatalf("expected 1 list request, got %d", got)
+	}
+
+	// Writes drop the cached list
+	cache.InvalidatePermissions()
+	if _, err := cache.Permissions(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := listRequests.Load(); got != 2 {
+		t.Fatalf("expected 2 list requests after invalidation, got %d", got)
+	}
+}
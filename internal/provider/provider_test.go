@@ -4,11 +4,15 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/nxt-fwd/kinde-go"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -35,3 +39,34 @@ func testAccPreCheck(t *testing.T) {
 		}
 	}
 }
+
+// newTestServer starts a fake Kinde API that is closed when the test ends.
+// Token requests are answered by the server and every other request is passed
+// to handler with a JSON content type already set.
+func newTestServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/oauth2/token" {
+			_, _ = w.Write([]byte(`{"access_token":"test","token_type":"bearer","expires_in":3600}`))
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestClient returns a client for the fake Kinde API started by
+// newTestServer.
+func newTestClient(server *httptest.Server) *kinde.Client {
+	opts := kinde.NewClientOptions().
+		WithDomain(server.URL).
+		WithAudience(server.URL + "/api").
+		WithClientID("test").
+		WithClientSecret("test")
+	client := kinde.New(context.Background(), opts)
+	return &client
+}
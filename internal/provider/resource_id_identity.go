@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceIDIdentityModel is the identity of resources that are identified by
+// their id attribute alone.
+type resourceIDIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// resourceIDIdentity returns the identity of the resource with the given ID.
+func resourceIDIdentity(id types.String) resourceIDIdentityModel {
+	return resourceIDIdentityModel{ID: id}
+}
+
+// resourceIDIdentitySchema returns the identity schema of resources that are
+// identified by their id attribute alone.
+func resourceIDIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// importID returns the ID to import, which is either the import ID or the id
+// of the identity when importing with an identity.
+func importID(ctx context.Context, req resource.ImportStateRequest) (string, diag.Diagnostics) {
+	if req.ID != "" {
+		return req.ID, nil
+	}
+
+	// Import block with an identity instead of an ID
+	var identity resourceIDIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	return identity.ID.ValueString(), diags
+}
+
+// importCompositeID imports a resource whose id joins the given attributes
+// with colons. The attributes are read from the import ID, or from the
+// identity when importing with an identity, and written to the state and the
+// identity. The identity attributes must share the names of the resource
+// attributes.
+func importCompositeID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...string) {
+	format := strings.Join(attributes, ":")
+	values := make([]string, len(attributes))
+
+	if req.ID != "" {
+		parts, err := splitID(req.ID, len(attributes), format)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				err.Error(),
+			)
+			return
+		}
+		copy(values, parts)
+	} else {
+		// Import block with an identity instead of an ID
+		for i, attribute := range attributes {
+			var value types.String
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(attribute), &value)...)
+			values[i] = value.ValueString()
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for i, attribute := range attributes {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), values[i])...)
+		resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root(attribute), values[i])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join(values, ":"))...)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImportCompositeID(t *testing.T) {
+	ctx := context.Background()
+	r := &UserRoleResource{}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	var identitySchemaResp fwresource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	importIdentity := &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema}
+	if diags := importIdentity.Set(ctx, UserRoleResourceIdentityModel{
+		OrganizationCode: types.StringValue("org_1"),
+		UserID:           types.StringValue("kp_1"),
+		RoleID:           types.StringValue("role_1"),
+	}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	tests := []struct {
+		name    string
+		req     fwresource.ImportStateRequest
+		wantErr bool
+	}{
+		{
+			name: "import ID",
+			req:  fwresource.ImportStateRequest{ID: "org_1:kp_1:role_1"},
+		},
+		{
+			name: "identity",
+			req:  fwresource.ImportStateRequest{Identity: importIdentity},
+		},
+		{
+			name:    "wrong number of parts",
+			req:     fwresource.ImportStateRequest{ID: "org_1:kp_1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := fwresource.ImportStateResponse{
+				State:    tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+				Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema, Raw: tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil)},
+			}
+			r.ImportState(ctx, tt.req, &resp)
+
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Invalid Import ID" {
+					t.Fatalf("expected invalid import ID error, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var state UserRoleResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.ID.ValueString() != "org_1:kp_1:role_1" || state.OrganizationCode.ValueString() != "org_1" || state.UserID.ValueString() != "kp_1" || state.RoleID.ValueString() != "role_1" {
+				t.Errorf("unexpected state: %+v", state)
+			}
+
+			var identity UserRoleResourceIdentityModel
+			resp.Diagnostics.Append(resp.Identity.Get(ctx, &identity)...)
+			if identity.OrganizationCode.ValueString() != "org_1" || identity.UserID.ValueString() != "kp_1" || identity.RoleID.ValueString() != "role_1" {
+				t.Errorf("unexpected identity: %+v", identity)
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
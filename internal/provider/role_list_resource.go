@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/roles"
+)
+
+var _ list.ListResourceWithConfigure = &RoleResource{}
+
+func NewRoleListResource() list.ListResource {
+	return &RoleResource{}
+}
+
+func (r *RoleResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceConfigSchema("Lists all Kinde roles.")
+}
+
+func (r *RoleResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = listResults(ctx, req, "roles", listAllRoles(ctx, r.client), func(ctx context.Context, role roles.Role, result *list.ListResult) {
+		result.DisplayName = role.Name
+		setListResult(ctx, req, result, types.StringValue(role.ID), func() (RoleResourceModel, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			// The role list does not include permissions
+			perms, err := r.client.GetRolePermissions(ctx, role.ID)
+			if err != nil {
+				diags.AddError(
+					"Error Reading Role",
+					fmt.Sprintf("Could not read permissions of role ID %s: %s", role.ID, err),
+				)
+				return RoleResourceModel{}, diags
+			}
+
+			state, err := flattenRoleResource(ctx, &role, sortPermissions(perms))
+			if err != nil {
+				diags.AddError(
+					"Error Setting Role State",
+					"Could not set role state: "+err.Error(),
+				)
+			}
+			return state, diags
+		})
+	})
+}
@@ -7,19 +7,20 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/nxt-fwd/kinde-go"
 	"github.com/nxt-fwd/kinde-go/api/roles"
 )
 
 var (
 	_ resource.Resource                = &RoleResource{}
 	_ resource.ResourceWithImportState = &RoleResource{}
+	_ resource.ResourceWithIdentity    = &RoleResource{}
 )
 
 func NewRoleResource() resource.Resource {
@@ -28,6 +29,7 @@ func NewRoleResource() resource.Resource {
 
 type RoleResource struct {
 	client *roles.Client
+	cache  *providerCache
 }
 
 func (r *RoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,21 +67,26 @@ func (r *RoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *RoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIDIdentitySchema("ID of the role")
+}
+
 func (r *RoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*kinde.Client)
+	client, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *kinde.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	r.client = client.Roles
+	r.cache = client.cache
 }
 
 func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -101,6 +108,8 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	r.cache.InvalidateRoles()
+
 	// Get the complete role data
 	role, err = r.client.Get(ctx, role.ID)
 	if err != nil {
@@ -167,6 +176,7 @@ func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 }
 
 // Helper function to sort permissions without modifying original
@@ -177,6 +187,31 @@ func sortPermissions(permissions []string) []string {
 	return sorted
 }
 
+// readRole returns the role with its permissions. The role details come from
+// the cached role list, so only the permissions are requested per role.
+func (r *RoleResource) readRole(ctx context.Context, id string) (*roles.Role, error) {
+	allRoles, err := r.cache.Roles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range allRoles {
+		if role.ID != id {
+			continue
+		}
+
+		perms, err := r.client.GetRolePermissions(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role permissions: %w", err)
+		}
+		role.Permissions = perms
+		return &role, nil
+	}
+
+	// Let the API report roles that are not listed
+	return r.client.Get(ctx, id)
+}
+
 func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state RoleResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -185,7 +220,7 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	role, err := r.client.Get(ctx, state.ID.ValueString())
+	role, err := r.readRole(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Role",
@@ -195,30 +230,18 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Sort permissions without modifying original
-	sortedPerms := sortPermissions(role.Permissions)
-
-	// If no permissions are returned, explicitly set to null
-	var permissionsSet types.Set
-	if len(sortedPerms) > 0 {
-		permissionsSet, diags = types.SetValueFrom(ctx, types.StringType, sortedPerms)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	} else {
-		permissionsSet = types.SetNull(types.StringType)
-	}
-
-	state = RoleResourceModel{
-		ID:          types.StringValue(role.ID),
-		Name:        types.StringValue(role.Name),
-		Key:         types.StringValue(role.Key),
-		Description: types.StringValue(role.Description),
-		Permissions: permissionsSet,
+	state, err = flattenRoleResource(ctx, role, sortPermissions(role.Permissions))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Setting Role State",
+			"Could not set role state: "+err.Error(),
+		)
+		return
 	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 }
 
 func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -247,6 +270,8 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	r.cache.InvalidateRoles()
+
 	// Handle permissions update if the field is set in the plan
 	var planPerms []string
 	if !plan.Permissions.IsNull() {
@@ -362,6 +387,7 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 }
 
 func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -379,14 +405,34 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		)
 		return
 	}
+
+	r.cache.InvalidateRoles()
 }
 
 func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	role, err := r.client.Get(ctx, req.ID)
+	id, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if key, ok := strings.CutPrefix(id, importKeyPrefix); ok {
+		var err error
+		id, err = r.roleIDByKey(ctx, key)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Kinde Role",
+				"Could not find Kinde role with key "+key+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	role, err := r.client.Get(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Kinde Role",
-			"Could not read Kinde role ID "+req.ID+": "+err.Error(),
+			"Could not read Kinde role ID "+id+": "+err.Error(),
 		)
 		return
 	}
@@ -403,5 +449,21 @@ func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
-	resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
+}
+
+// roleIDByKey resolves the ID of the role with the given key.
+func (r *RoleResource) roleIDByKey(ctx context.Context, key string) (string, error) {
+	allRoles, err := r.cache.Roles(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, role := range allRoles {
+		if role.Key == key {
+			return role.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no role with key %s exists", key)
 }
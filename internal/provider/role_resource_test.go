@@ -32,6 +32,13 @@ func TestAccRoleResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by key testing
+			{
+				ResourceName:      "kinde_role.test",
+				ImportState:       true,
+				ImportStateId:     "key:" + testID,
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
 				Config: testAccRoleResourceConfigUpdate(testID),
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &KindeURLFunction{}
+
+func NewBuildIssuerURLFunction() function.Function {
+	return &KindeURLFunction{
+		name:    "build_issuer_url",
+		summary: "Build the issuer URL of a Kinde domain",
+		path:    "",
+	}
+}
+
+func NewBuildAuthorizeURLFunction() function.Function {
+	return &KindeURLFunction{
+		name:    "build_authorize_url",
+		summary: "Build the authorization endpoint URL of a Kinde domain",
+		path:    "/oauth2/auth",
+	}
+}
+
+func NewBuildJWKSURLFunction() function.Function {
+	return &KindeURLFunction{
+		name:    "build_jwks_url",
+		summary: "Build the JSON Web Key Set URL of a Kinde domain",
+		path:    "/.well-known/jwks.json",
+	}
+}
+
+// KindeURLFunction builds the URL of an endpoint of a Kinde domain.
+type KindeURLFunction struct {
+	name    string
+	summary string
+	// path is the path of the endpoint, relative to the issuer URL
+	path string
+}
+
+func (f *KindeURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *KindeURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	description := "Returns the issuer URL of a Kinde domain, e.g. `https://example.kinde.com`."
+	if f.path != "" {
+		description = fmt.Sprintf("Returns the URL of the `%s` endpoint of a Kinde domain, e.g. `https://example.kinde.com%s`.", f.path, f.path)
+	}
+
+	resp.Definition = function.Definition{
+		Summary:             f.summary,
+		MarkdownDescription: description + " The domain may be given with or without `https://` and a trailing slash, e.g. `example.kinde.com`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "domain",
+				MarkdownDescription: "Kinde domain, e.g. `https://example.kinde.com` or a custom domain",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *KindeURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var domain string
+	resp.Error = req.Arguments.Get(ctx, &domain)
+	if resp.Error != nil {
+		return
+	}
+
+	issuer, err := issuerURL(domain)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, issuer+f.path)
+}
+
+// issuerURL returns the issuer URL of a Kinde domain. Domains without a scheme
+// use https.
+func issuerURL(domain string) (string, error) {
+	domain = strings.TrimSuffix(strings.TrimSpace(domain), "/")
+	if !strings.Contains(domain, "://") {
+		domain = "https://" + domain
+	}
+
+	parsed, err := url.Parse(domain)
+	if err != nil {
+		return "", fmt.Errorf("invalid domain: %w", err)
+	}
+	if parsed.Host == "" || (parsed.Path != "" && parsed.Path != "/") || parsed.RawQuery != "" || parsed.Fragment != "" {
+		return "", fmt.Errorf("invalid domain %q. Expected a host name, e.g. example.kinde.com", domain)
+	}
+
+	return parsed.Scheme + "://" + parsed.Host, nil
+}
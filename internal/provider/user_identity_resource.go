@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nxt-fwd/kinde-go/api/identities"
+	"github.com/nxt-fwd/kinde-go/api/users"
+)
+
+var (
+	_ resource.Resource                   = &UserIdentityResource{}
+	_ resource.ResourceWithImportState    = &UserIdentityResource{}
+	_ resource.ResourceWithIdentity       = &UserIdentityResource{}
+	_ resource.ResourceWithValidateConfig = &UserIdentityResource{}
+)
+
+func NewUserIdentityResource() resource.Resource {
+	return &UserIdentityResource{}
+}
+
+type UserIdentityResource struct {
+	client     *users.Client
+	identities *identities.Client
+}
+
+type UserIdentityResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	UserID     types.String `tfsdk:"user_id"`
+	IdentityID types.String `tfsdk:"identity_id"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+}
+
+// UserIdentityResourceIdentityModel identifies an identity by user and
+// identity ID.
+type UserIdentityResourceIdentityModel struct {
+	UserID     types.String `tfsdk:"user_id"`
+	IdentityID types.String `tfsdk:"identity_id"`
+}
+
+func userIdentityIdentity(model UserIdentityResourceModel) UserIdentityResourceIdentityModel {
+	return UserIdentityResourceIdentityModel{
+		UserID:     model.UserID,
+		IdentityID: model.IdentityID,
+	}
+}
+
+func (r *UserIdentityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_identity"
+}
+
+func (r *UserIdentityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single identity of a Kinde user, e.g. a secondary email or a phone number. Other identities of the user are left untouched. When the user is managed by a `kinde_user` resource, which manages all identities of the user, add `identities` to its `ignore_changes` so the identity is not removed again.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Composite ID of the user identity",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"identity_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the identity",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the identity, one of `email`, `username` or `phone`",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Value of the identity, e.g. the email address. Phone numbers are given in international format, e.g. `+15551234567`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *UserIdentityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserIdentityResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+
+	if !canAddIdentity(data.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Unsupported Identity Type",
+			unsupportedIdentityTypeError(data.Type.ValueString(), data.Value.ValueString()),
+		)
+	}
+}
+
+func (r *UserIdentityResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"user_id": identityschema.StringAttribute{
+				Description:       "ID of the user",
+				RequiredForImport: true,
+			},
+			"identity_id": identityschema.StringAttribute{
+				Description:       "ID of the identity",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *UserIdentityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Users
+	r.identities = client.Identities
+}
+
+func (r *UserIdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserIdentityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := plan.UserID.ValueString()
+	tflog.Debug(ctx, "Adding user identity", map[string]interface{}{
+		"user_id": userID,
+		"type":    plan.Type.ValueString(),
+	})
+
+	identity, err := r.client.AddIdentity(ctx, userID, users.AddIdentityParams{
+		Type:  users.IdentityType(plan.Type.ValueString()),
+		Value: plan.Value.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Adding User Identity",
+			fmt.Sprintf("Could not add identity to user %s: %s", userID, err),
+		)
+		return
+	}
+
+	identityID := identity.ID
+	if identityID == "" {
+		// The response does not always include the new identity, so it is
+		// looked up by type and value instead
+		identityID, err = r.findIdentityID(ctx, userID, plan.Type.ValueString(), plan.Value.ValueString())
+		if err != nil {
+			// Keep the added identity in state without its ID, so it is not
+			// orphaned. The resource is tainted and the ID is looked up again
+			// on the next refresh or on deletion.
+			resp.Diagnostics.AddError(
+				"Error Reading User Identities",
+				fmt.Sprintf("Could not read identities for user %s: %s", userID, err),
+			)
+			plan.IdentityID = types.StringNull()
+			plan.ID = types.StringNull()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, userIdentityIdentity(plan))...)
+			return
+		}
+	}
+
+	plan.IdentityID = types.StringValue(identityID)
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", userID, identityID))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, userIdentityIdentity(plan))...)
+}
+
+func (r *UserIdentityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserIdentityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIdentities, err := r.client.GetIdentities(ctx, state.UserID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User Identities",
+			fmt.Sprintf("Could not read identities for user %s: %s", state.UserID.ValueString(), err),
+		)
+		return
+	}
+
+	identity := findUserIdentity(userIdentities, state.IdentityID, state.Type, state.Value)
+	if identity == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Identities whose ID could not be looked up after creation get it here
+	state.IdentityID = types.StringValue(identity.ID)
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", state.UserID.ValueString(), identity.ID))
+
+	// Keep the configured type and value, the API may return e.g. phone
+	// numbers in a different format. Imported identities have neither.
+	if state.Type.IsNull() {
+		state.Type = types.StringValue(identity.Type)
+	}
+	if state.Value.IsNull() {
+		state.Value = types.StringValue(identity.Name)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, userIdentityIdentity(state))...)
+}
+
+func (r *UserIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// No updates are possible, all fields require replacement
+	var plan UserIdentityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, userIdentityIdentity(plan))...)
+}
+
+func (r *UserIdentityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserIdentityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityID := state.IdentityID.ValueString()
+	if state.IdentityID.IsNull() {
+		var err error
+		identityID, err = r.findIdentityID(ctx, state.UserID.ValueString(), state.Type.ValueString(), state.Value.ValueString())
+		var notFound *userIdentityNotFoundError
+		if errors.As(err, &notFound) {
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading User Identities",
+				fmt.Sprintf("Could not read identities for user %s: %s", state.UserID.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	if err := r.identities.Delete(ctx, identityID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Removing User Identity",
+			fmt.Sprintf("Could not remove identity %s from user %s: %s", identityID, state.UserID.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *UserIdentityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCompositeID(ctx, req, resp, "user_id", "identity_id")
+}
+
+// userIdentityNotFoundError is returned by findIdentityID when the user has
+// no identity with the given type and value.
+type userIdentityNotFoundError struct {
+	identityType string
+	value        string
+}
+
+func (e *userIdentityNotFoundError) Error() string {
+	return fmt.Sprintf("no %s identity with value %s exists", e.identityType, e.value)
+}
+
+// findIdentityID returns the ID of the identity of a user with the given type
+// and value.
+func (r *UserIdentityResource) findIdentityID(ctx context.Context, userID, identityType, value string) (string, error) {
+	userIdentities, err := r.client.GetIdentities(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	identity := findUserIdentity(userIdentities, types.StringNull(), types.StringValue(identityType), types.StringValue(value))
+	if identity == nil {
+		return "", &userIdentityNotFoundError{identityType: identityType, value: value}
+	}
+	return identity.ID, nil
+}
+
+// findUserIdentity returns the identity with the given ID or, if the ID is
+// null, the identity with the given type and value.
+func findUserIdentity(userIdentities []users.Identity, identityID, identityType, value types.String) *users.Identity {
+	for i := range userIdentities {
+		identity := &userIdentities[i]
+		if identityID.IsNull() {
+			if identity.Type == identityType.ValueString() && identity.Name == value.ValueString() {
+				return identity
+			}
+		} else if identity.ID == identityID.ValueString() {
+			return identity
+		}
+	}
+	return nil
+}
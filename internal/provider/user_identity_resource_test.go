@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/nxt-fwd/kinde-go/api/users"
+)
+
+func TestAccUserIdentityResource(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Resource identity requires Terraform 1.12
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserIdentityResourceConfig(testID, "secondary"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("kinde_user_identity.test", "user_id", "kinde_user.test", "id"),
+					resource.TestCheckResourceAttrSet("kinde_user_identity.test", "identity_id"),
+					resource.TestCheckResourceAttr("kinde_user_identity.test", "value", testID+"-secondary@example.com"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("kinde_user_identity.test", tfjsonpath.New("user_id")),
+					statecheck.ExpectIdentityValueMatchesState("kinde_user_identity.test", tfjsonpath.New("identity_id")),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "kinde_user_identity.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Import with an identity instead of an ID
+			{
+				ResourceName:    "kinde_user_identity.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			// Changing the value replaces the identity
+			{
+				Config: testAccUserIdentityResourceConfig(testID, "other"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kinde_user_identity.test", "value", testID+"-other@example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccUserIdentityResource_UnsupportedType(t *testing.T) {
+	testID := acctest.RandomWithPrefix("tfacc")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "kinde_user_identity" "test" {
+	user_id = "kp_1234"
+	type    = "social"
+	value   = %q
+}
+`, testID+"@example.com"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unsupported Identity Type"),
+			},
+		},
+	})
+}
+
+func TestUserIdentityResource_ValidateConfigType(t *testing.T) {
+	r := &UserIdentityResource{}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		identityType types.String
+		wantErr      bool
+	}{
+		{identityType: types.StringValue("email")},
+		{identityType: types.StringValue("username")},
+		{identityType: types.StringValue("phone")},
+		{identityType: types.StringUnknown()},
+		{identityType: types.StringValue("social"), wantErr: true},
+		{identityType: types.StringValue("enterprise"), wantErr: true},
+		{identityType: types.StringValue("oauth2:google"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		config := tfsdk.Config{Schema: schemaResp.Schema}
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, UserIdentityResourceModel{
+			ID:         types.StringUnknown(),
+			UserID:     types.StringValue("kp_1"),
+			IdentityID: types.StringUnknown(),
+			Type:       tt.identityType,
+			Value:      types.StringValue("alice"),
+		}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		config.Raw = state.Raw
+
+		var resp fwresource.ValidateConfigResponse
+		r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: config}, &resp)
+
+		switch {
+		case !tt.wantErr && resp.Diagnostics.HasError():
+			t.Errorf("%s: unexpected error: %v", tt.identityType, resp.Diagnostics)
+		case tt.wantErr && (!resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Unsupported Identity Type"):
+			t.Errorf("%s: expected unsupported identity type error, got %v", tt.identityType, resp.Diagnostics)
+		}
+	}
+}
+
+func TestFindUserIdentity(t *testing.T) {
+	userIdentities := []users.Identity{
+		{ID: "identity_username", Type: "username", Name: "alice"},
+		{ID: "identity_email", Type: "email", Name: "alice"},
+	}
+
+	tests := []struct {
+		name         string
+		identityID   types.String
+		identityType string
+		expected     string
+	}{
+		{name: "by id", identityID: types.StringValue("identity_username"), identityType: "email", expected: "identity_username"},
+		{name: "by type and value", identityID: types.StringNull(), identityType: "email", expected: "identity_email"},
+		{name: "missing type", identityID: types.StringNull(), identityType: "phone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity := findUserIdentity(userIdentities, tt.identityID, types.StringValue(tt.identityType), types.StringValue("alice"))
+
+			var actual string
+			if identity != nil {
+				actual = identity.ID
+			}
+			if actual != tt.expected {
+				t.Errorf("expected identity %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func testAccUserIdentityResourceConfig(testID, secondary string) string {
+	return fmt.Sprintf(`
+resource "kinde_user" "test" {
+	first_name = "Identity"
+	last_name  = "User"
+
+	identities = [
+		{
+			type  = "email"
+			value = "%[1]s@example.com"
+		}
+	]
+
+	lifecycle {
+		ignore_changes = [identities]
+	}
+}
+
+resource "kinde_user_identity" "test" {
+	user_id = kinde_user.test.id
+	type    = "email"
+	value   = "%[1]s-%[2]s@example.com"
+}
+`, testID, secondary)
+}
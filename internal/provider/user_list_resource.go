@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/users"
+)
+
+var _ list.ListResourceWithConfigure = &UserResource{}
+
+func NewUserListResource() list.ListResource {
+	return &UserResource{}
+}
+
+func (r *UserResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listResourceConfigSchema("Lists all Kinde users.")
+}
+
+func (r *UserResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	stream.Results = listResults(ctx, req, "users", listAllUsers(ctx, r.client), func(ctx context.Context, user users.User, result *list.ListResult) {
+		result.DisplayName = user.PreferredEmail
+		if result.DisplayName == "" {
+			result.DisplayName = user.ID
+		}
+
+		setListResult(ctx, req, result, types.StringValue(user.ID), func() (UserResourceModel, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			identities, err := r.client.GetIdentities(ctx, user.ID)
+			if err != nil {
+				diags.AddError(
+					"Error Reading User Identities",
+					fmt.Sprintf("Could not read identities for user ID %s: %s", user.ID, err),
+				)
+				return UserResourceModel{}, diags
+			}
+
+			// Names are required by the schema, so empty names are kept
+			// rather than left null
+			state := UserResourceModel{
+				FirstName: types.StringValue(user.FirstName),
+				LastName:  types.StringValue(user.LastName),
+			}
+
+			diags.Append(flattenUserResource(ctx, &user, identities, &state)...)
+			return state, diags
+		})
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nxt-fwd/kinde-go/api/users"
+)
+
+var (
+	_ action.Action              = &UserRefreshClaimsAction{}
+	_ action.ActionWithConfigure = &UserRefreshClaimsAction{}
+)
+
+func NewUserRefreshClaimsAction() action.Action {
+	return &UserRefreshClaimsAction{}
+}
+
+type UserRefreshClaimsAction struct {
+	client *users.Client
+}
+
+type UserRefreshClaimsActionModel struct {
+	UserID types.String `tfsdk:"user_id"`
+}
+
+func (a *UserRefreshClaimsAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_refresh_claims"
+}
+
+func (a *UserRefreshClaimsAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Refreshes the claims of a user, so that changes to their roles, permissions or organizations are included in the next token they are issued. See [documentation](https://docs.kinde.com/kinde-apis/management/#tag/users/post/api/v1/users/{user_id}/refresh_claims) for more details.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *UserRefreshClaimsAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client.Users
+}
+
+func (a *UserRefreshClaimsAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config UserRefreshClaimsActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := config.UserID.ValueString()
+	tflog.Debug(ctx, "Refreshing user claims", map[string]interface{}{
+		"user_id": userID,
+	})
+
+	if err := refreshUserClaims(ctx, a.client, userID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Refreshing User Claims",
+			fmt.Sprintf("Could not refresh claims of user %s: %s", userID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Refreshed claims of user %s", userID)})
+}
+
+// refreshUserClaims calls the refresh claims endpoint, which the users client
+// does not wrap.
+func refreshUserClaims(ctx context.Context, client *users.Client, userID string) error {
+	request, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("/api/v1/users/%s/refresh_claims", userID), nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return client.DoRequest(request, nil)
+}
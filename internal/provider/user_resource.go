@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"sort"
 	"strings"
 
@@ -14,13 +16,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nxt-fwd/kinde-go"
+	"github.com/nxt-fwd/kinde-go/api/identities"
 	"github.com/nxt-fwd/kinde-go/api/users"
 )
 
 var (
-	_ resource.Resource                = &UserResource{}
-	_ resource.ResourceWithImportState = &UserResource{}
+	_ resource.Resource                   = &UserResource{}
+	_ resource.ResourceWithImportState    = &UserResource{}
+	_ resource.ResourceWithIdentity       = &UserResource{}
+	_ resource.ResourceWithValidateConfig = &UserResource{}
 )
 
 func NewUserResource() resource.Resource {
@@ -28,7 +32,8 @@ func NewUserResource() resource.Resource {
 }
 
 type UserResource struct {
-	client *users.Client
+	client     *users.Client
+	identities *identities.Client
 }
 
 type UserResourceModel struct {
@@ -40,6 +45,8 @@ type UserResourceModel struct {
 	CreatedOn        types.String `tfsdk:"created_on"`
 	UpdatedOn        types.String `tfsdk:"updated_on"`
 	Identities       types.Set    `tfsdk:"identities"`
+
+	ManageOAuth2Identities types.Bool `tfsdk:"manage_oauth2_identities"`
 }
 
 func (r *UserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,7 +76,7 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"is_suspended": schema.BoolAttribute{
 				Optional:    true,
-				Description: "Whether the user is suspended.",
+				Description: "Whether the user is suspended. Users created with `is_suspended = true` are suspended right after creation, and deleted again if that fails. A user that cannot be deleted either is kept in state and marked as tainted.",
 			},
 			"organization_code": schema.StringAttribute{
 				Optional:    true,
@@ -90,12 +97,12 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"identities": schema.SetNestedAttribute{
-				Description: "Identities for the user (email, username, phone, etc.).",
+				Description: "Identities for the user (email, username, phone, etc.). Identities removed from this set are deleted from the user, add `identities` to `ignore_changes` when identities of the user are managed with `kinde_user_identity`. Only email, username and phone identities can be added, enterprise, social and oauth2:* identities are created when the user signs in through the connection. Enterprise and social identities are ignored, oauth2:* identities unless `manage_oauth2_identities` is set.",
 				Required:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"type": schema.StringAttribute{
-							Description: "The type of identity (email, username, phone, enterprise, social).",
+							Description: "The type of identity (email, username, phone, enterprise, social or oauth2:*, e.g. oauth2:google).",
 							Required:    true,
 						},
 						"value": schema.StringAttribute{
@@ -105,25 +112,78 @@ func (r *UserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					},
 				},
 			},
+			"manage_oauth2_identities": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether oauth2:* identities, which Kinde creates when the user signs in with a social connection, are managed as part of `identities`. By default they are ignored. When enabled they are read into `identities`, and removing them from the configuration deletes them from the user.",
+			},
 		},
 	}
 }
 
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Identities.IsNull() || data.Identities.IsUnknown() {
+		return
+	}
+
+	var identities []struct {
+		Type  types.String `tfsdk:"type"`
+		Value types.String `tfsdk:"value"`
+	}
+	resp.Diagnostics.Append(data.Identities.ElementsAs(ctx, &identities, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, identity := range identities {
+		if identity.Type.IsUnknown() {
+			continue
+		}
+
+		if isConnectionIdentity(identity.Type.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("identities"),
+				"Unsupported Identity Type",
+				unsupportedIdentityTypeError(identity.Type.ValueString(), identity.Value.ValueString()),
+			)
+			continue
+		}
+
+		if isOAuth2Identity(identity.Type.ValueString()) && !data.ManageOAuth2Identities.IsUnknown() && !data.ManageOAuth2Identities.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("identities"),
+				"Unmanaged OAuth2 Identity",
+				fmt.Sprintf("Identity %s of type %s is ignored unless manage_oauth2_identities is set to true.", identity.Value.ValueString(), identity.Type.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *UserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIDIdentitySchema("ID of the user")
+}
+
 func (r *UserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*kinde.Client)
+	client, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *kinde.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	r.client = client.Users
+	r.identities = client.Identities
 }
 
 func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -136,15 +196,6 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	// Check if is_suspended is set to true on create, which is not allowed
-	if !plan.IsSuspended.IsNull() && plan.IsSuspended.ValueBool() {
-		resp.Diagnostics.AddError(
-			"Invalid Configuration",
-			"Setting is_suspended=true when creating a user is not supported. Create the user first, then update the is_suspended attribute.",
-		)
-		return
-	}
-
 	// Validate that at least one email identity is provided
 	var identities []struct {
 		Type  string `tfsdk:"type"`
@@ -162,6 +213,13 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		if identity.Type == string(users.IdentityTypeEmail) {
 			hasEmail = true
 		}
+		if !canAddIdentity(identity.Type) {
+			resp.Diagnostics.AddError(
+				"Unsupported Identity Type",
+				unsupportedIdentityTypeError(identity.Type, identity.Value),
+			)
+			return
+		}
 		details := make(map[string]string)
 		switch identity.Type {
 		case string(users.IdentityTypeEmail):
@@ -214,6 +272,29 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Users cannot be created suspended, so the suspension is a second call
+	if plan.IsSuspended.ValueBool() {
+		if deleted, err := r.suspendCreatedUser(ctx, user.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Suspending Created User",
+				fmt.Sprintf("Could not suspend created user %s: %s", user.ID, err),
+			)
+
+			// Keep track of a user that could not be rolled back, so Terraform
+			// marks it as tainted and replaces it instead of leaving an active
+			// account behind
+			if !deleted {
+				plan.ID = types.StringValue(user.ID)
+				plan.IsSuspended = types.BoolValue(false)
+				plan.CreatedOn = types.StringValue(user.CreatedOn.String())
+				plan.UpdatedOn = types.StringValue(user.UpdatedOn.String())
+				resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+				resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(plan.ID))...)
+			}
+			return
+		}
+	}
+
 	// Get the final state of the user
 	user, err = r.client.Get(ctx, user.ID)
 	if err != nil {
@@ -247,8 +328,8 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	for _, identity := range finalIdentities {
-		// Skip OAuth2 identities when storing in state
-		if strings.HasPrefix(identity.Type, "oauth2:") {
+		// Skip identities that cannot be configured when storing in state
+		if !isManagedIdentity(identity.Type, plan.ManageOAuth2Identities) {
 			continue
 		}
 
@@ -315,6 +396,7 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(plan.ID))...)
 }
 
 func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -344,101 +426,15 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// Convert identities to Terraform state
-	var tfIdentities []struct {
-		Type  string `tfsdk:"type"`
-		Value string `tfsdk:"value"`
-	}
-
-	// If we have existing state identities, use them to preserve the types
-	var stateIdentitiesMap map[string]string
-	if !state.Identities.IsNull() {
-		stateIdentitiesMap = make(map[string]string)
-		var stateIdentities []struct {
-			Type  string `tfsdk:"type"`
-			Value string `tfsdk:"value"`
-		}
-		diags = state.Identities.ElementsAs(ctx, &stateIdentities, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		// Create a map of value -> type from state
-		for _, identity := range stateIdentities {
-			stateIdentitiesMap[identity.Value] = identity.Type
-		}
-	}
-
-	// Process API identities
-	for _, identity := range identities {
-		// Skip OAuth2 identities when storing in state
-		if strings.HasPrefix(identity.Type, "oauth2:") {
-			continue
-		}
-
-		// Use the type from state if available, otherwise use API type
-		identityType := identity.Type
-		if stateIdentitiesMap != nil {
-			if stateType, exists := stateIdentitiesMap[identity.Name]; exists {
-				identityType = stateType
-			}
-		}
-
-		tfIdentities = append(tfIdentities, struct {
-			Type  string `tfsdk:"type"`
-			Value string `tfsdk:"value"`
-		}{
-			Type:  identityType,
-			Value: identity.Name,
-		})
-	}
-
-	// Sort identities consistently by type and then by value
-	sort.Slice(tfIdentities, func(i, j int) bool {
-		if tfIdentities[i].Type == tfIdentities[j].Type {
-			return tfIdentities[i].Value < tfIdentities[j].Value
-		}
-		return tfIdentities[i].Type < tfIdentities[j].Type
-	})
-
-	// Update state with user data
-	state.ID = types.StringValue(user.ID)
-
-	// Handle first_name: only set if it was previously set in state
-	if !state.FirstName.IsNull() {
-		state.FirstName = types.StringValue(user.FirstName)
-	}
-
-	// Handle last_name: only set if it was previously set in state
-	if !state.LastName.IsNull() {
-		state.LastName = types.StringValue(user.LastName)
-	}
-
-	// Only set is_suspended in state if it was previously configured
-	if !state.IsSuspended.IsNull() {
-		state.IsSuspended = types.BoolValue(user.IsSuspended)
-	}
-
-	state.CreatedOn = types.StringValue(user.CreatedOn.String())
-	state.UpdatedOn = types.StringValue(user.UpdatedOn.String())
-
-	// Convert identities to set
-	identitiesSet, diags := types.SetValueFrom(ctx, types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"type":  types.StringType,
-			"value": types.StringType,
-		},
-	}, tfIdentities)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(flattenUserResource(ctx, user, identities, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state.Identities = identitiesSet
 
 	// Set state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(state.ID))...)
 }
 
 func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -523,7 +519,8 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Get current identities from the API to identify OAuth2 identities
+	// Get current identities from the API to identify OAuth2 identities and
+	// the IDs of identities to remove
 	currentIdentities, err := r.client.GetIdentities(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -533,23 +530,6 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// Extract OAuth2 identities to preserve (we won't add them to state, but we need to avoid removing them)
-	var oauth2Identities []struct {
-		Type  string `tfsdk:"type"`
-		Value string `tfsdk:"value"`
-	}
-	for _, identity := range currentIdentities {
-		if strings.HasPrefix(identity.Type, "oauth2:") {
-			oauth2Identities = append(oauth2Identities, struct {
-				Type  string `tfsdk:"type"`
-				Value string `tfsdk:"value"`
-			}{
-				Type:  identity.Type,
-				Value: identity.Name,
-			})
-		}
-	}
-
 	// Get planned identities
 	var plannedIdentities []struct {
 		Type  string `tfsdk:"type"`
@@ -561,13 +541,9 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	// For validation and identity management, we need to consider OAuth identities
-	// but we won't include them in the final state
-	allIdentities := append(plannedIdentities, oauth2Identities...)
-
 	// Validate that at least one email identity is provided
 	hasEmail := false
-	for _, identity := range allIdentities {
+	for _, identity := range plannedIdentities {
 		if identity.Type == string(users.IdentityTypeEmail) {
 			hasEmail = true
 			break
@@ -598,111 +574,92 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		existingIdentities[key] = true
 	}
 
-	// Also mark OAuth identities as existing so we don't try to add them again
-	for _, identity := range oauth2Identities {
-		key := identity.Type + ":" + identity.Value
-		existingIdentities[key] = true
+	// Also mark OAuth identities as existing, they are never added
+	for _, identity := range currentIdentities {
+		if isOAuth2Identity(identity.Type) {
+			existingIdentities[identity.Type+":"+identity.Name] = true
+		}
 	}
 
+	plannedKeys := make(map[string]bool, len(plannedIdentities))
 	for _, identity := range plannedIdentities {
-		// Skip OAuth2 identities as they are managed externally
-		if strings.HasPrefix(identity.Type, "oauth2:") {
+		key := identity.Type + ":" + identity.Value
+		plannedKeys[key] = true
+		if existingIdentities[key] {
 			continue
 		}
 
-		key := identity.Type + ":" + identity.Value
-		if !existingIdentities[key] {
-			addIdentityParams := users.AddIdentityParams{
-				Type:  users.IdentityType(identity.Type),
-				Value: identity.Value,
-			}
-
-			_, err := r.client.AddIdentity(ctx, plan.ID.ValueString(), addIdentityParams)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error Adding User Identity",
-					fmt.Sprintf("Could not add identity to user %s: %s", plan.ID.ValueString(), err),
-				)
-				return
-			}
+		if !canAddIdentity(identity.Type) {
+			resp.Diagnostics.AddError(
+				"Unsupported Identity Type",
+				unsupportedIdentityTypeError(identity.Type, identity.Value),
+			)
+			return
 		}
-	}
-
-	// Get final state of the user
-	user, err := r.client.Get(ctx, plan.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Updated User",
-			fmt.Sprintf("Could not read updated user %s: %s", plan.ID.ValueString(), err),
-		)
-		return
-	}
 
-	// Get final identities
-	finalIdentities, err := r.client.GetIdentities(ctx, plan.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading User Identities",
-			fmt.Sprintf("Could not read identities for user %s: %s", plan.ID.ValueString(), err),
-		)
-		return
-	}
+		addIdentityParams := users.AddIdentityParams{
+			Type:  users.IdentityType(identity.Type),
+			Value: identity.Value,
+		}
 
-	// Convert final identities to Terraform state format, excluding OAuth2 identities
-	var tfIdentities []struct {
-		Type  string `tfsdk:"type"`
-		Value string `tfsdk:"value"`
+		_, err := r.client.AddIdentity(ctx, plan.ID.ValueString(), addIdentityParams)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Adding User Identity",
+				fmt.Sprintf("Could not add identity to user %s: %s", plan.ID.ValueString(), err),
+			)
+			return
+		}
 	}
 
-	// Create a map of planned identity values to types for reference
-	plannedIdentityTypes := make(map[string]string)
-	for _, identity := range plannedIdentities {
-		plannedIdentityTypes[identity.Value] = identity.Type
+	// Remove identities that were dropped from the configuration. Identities
+	// are removed after the new ones were added, so the user keeps an email
+	// identity when it is replaced.
+	removedKeys := make(map[string]bool)
+	for _, identity := range stateIdentities {
+		key := identity.Type + ":" + identity.Value
+		if !plannedKeys[key] {
+			removedKeys[key] = true
+		}
 	}
 
-	for _, identity := range finalIdentities {
-		// Skip OAuth2 identities when storing in state
-		if strings.HasPrefix(identity.Type, "oauth2:") {
+	for _, identity := range currentIdentities {
+		if !removedKeys[identity.Type+":"+identity.Name] {
 			continue
 		}
-
-		// Use the type from plan if available, otherwise use API type
-		identityType := identity.Type
-		if plannedType, exists := plannedIdentityTypes[identity.Name]; exists {
-			identityType = plannedType
+		if isOAuth2Identity(identity.Type) && !plan.ManageOAuth2Identities.ValueBool() {
+			continue
 		}
 
-		tfIdentities = append(tfIdentities, struct {
-			Type  string `tfsdk:"type"`
-			Value string `tfsdk:"value"`
-		}{
-			Type:  identityType,
-			Value: identity.Name,
+		tflog.Debug(ctx, "Removing user identity", map[string]interface{}{
+			"id":          plan.ID.ValueString(),
+			"identity_id": identity.ID,
+			"type":        identity.Type,
 		})
-	}
 
-	// Sort identities consistently by type and then by value
-	sort.Slice(tfIdentities, func(i, j int) bool {
-		if tfIdentities[i].Type == tfIdentities[j].Type {
-			return tfIdentities[i].Value < tfIdentities[j].Value
+		if err := r.identities.Delete(ctx, identity.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Removing User Identity",
+				fmt.Sprintf("Could not remove identity %s from user %s: %s", identity.Name, plan.ID.ValueString(), err),
+			)
+			return
 		}
-		return tfIdentities[i].Type < tfIdentities[j].Type
-	})
+	}
 
-	// Convert identities to set
-	identitiesSet, diags := types.SetValueFrom(ctx, types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"type":  types.StringType,
-			"value": types.StringType,
-		},
-	}, tfIdentities)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	// Get final state of the user
+	user, err := r.client.Get(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Updated User",
+			fmt.Sprintf("Could not read updated user %s: %s", plan.ID.ValueString(), err),
+		)
 		return
 	}
 
-	// Update plan with final state
-	plan.Identities = identitiesSet
+	// The identities in state are the planned ones. Identities that exist on
+	// the user but were never in state, e.g. OAuth2 identities when
+	// manage_oauth2_identities was just enabled, are left alone and show up
+	// as a change on the next refresh.
 
 	// Only set name fields in state if they were in the plan
 	// This ensures that omitted fields stay omitted
@@ -723,6 +680,7 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(plan.ID))...)
 }
 
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -743,22 +701,37 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userRef, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := r.importUserID(ctx, userRef)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading User",
+			fmt.Sprintf("Could not find user %s: %s", userRef, err),
+		)
+		return
+	}
+
 	// Get the user by ID
-	user, err := r.client.Get(ctx, req.ID)
+	user, err := r.client.Get(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading User",
-			fmt.Sprintf("Could not read user ID %s: %s", req.ID, err),
+			fmt.Sprintf("Could not read user ID %s: %s", id, err),
 		)
 		return
 	}
 
 	// Get user identities
-	identities, err := r.client.GetIdentities(ctx, req.ID)
+	identities, err := r.client.GetIdentities(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading User Identities",
-			fmt.Sprintf("Could not read identities for user %s: %s", req.ID, err),
+			fmt.Sprintf("Could not read identities for user %s: %s", id, err),
 		)
 		return
 	}
@@ -797,4 +770,74 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("created_on"), user.CreatedOn.String())...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("updated_on"), user.UpdatedOn.String())...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("identities"), identitiesSet)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceIDIdentity(types.StringValue(user.ID)))...)
+}
+
+// suspendCreatedUser suspends a user that was just created. The user is
+// deleted again if the suspension fails, so a failed create does not leave
+// an active account behind. Reports whether the user was deleted.
+func (r *UserResource) suspendCreatedUser(ctx context.Context, userID string) (bool, error) {
+	isSuspended := true
+	_, err := r.client.Update(ctx, userID, users.UpdateParams{IsSuspended: &isSuspended})
+	if err == nil {
+		return false, nil
+	}
+
+	tflog.Debug(ctx, "Deleting user after failed suspension", map[string]interface{}{
+		"id": userID,
+	})
+
+	if deleteErr := r.client.Delete(ctx, userID); deleteErr != nil {
+		return false, fmt.Errorf("%w, and the user could not be deleted: %s", err, deleteErr)
+	}
+	return true, fmt.Errorf("%w, the user was deleted", err)
+}
+
+// userImportFilters are the user list filters that can be used as import ID
+// prefix, e.g. email:alice@example.com.
+var userImportFilters = []string{"email", "username"}
+
+// importUserID resolves an import ID to a user ID. Plain IDs are returned as is.
+func (r *UserResource) importUserID(ctx context.Context, importID string) (string, error) {
+	for _, filter := range userImportFilters {
+		if value, ok := strings.CutPrefix(importID, filter+":"); ok {
+			return findUserID(ctx, r.client, filter, value)
+		}
+	}
+	return importID, nil
+}
+
+// findUserID resolves the ID of the single user matching a user list filter.
+func findUserID(ctx context.Context, client *users.Client, filter, value string) (string, error) {
+	query := url.Values{}
+	query.Set(filter, value)
+
+	request, err := client.NewRequest(ctx, http.MethodGet, "/api/v1/users", query, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var response users.ListResponse
+	if err := client.DoRequest(request, &response); err != nil {
+		return "", err
+	}
+
+	switch len(response.Users) {
+	case 0:
+		return "", &userNotFoundError{filter: filter, value: value}
+	case 1:
+		return response.Users[0].ID, nil
+	default:
+		return "", fmt.Errorf("%d users match %s %s, import by ID instead", len(response.Users), filter, value)
+	}
+}
+
+// userNotFoundError is returned by findUserID when no user matches the filter.
+type userNotFoundError struct {
+	filter string
+	value  string
+}
+
+func (e *userNotFoundError) Error() string {
+	return fmt.Sprintf("no user with %s %s exists", e.filter, e.value)
 }
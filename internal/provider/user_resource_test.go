@@ -1,15 +1,24 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
-	"regexp"
+	"net/http"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/nxt-fwd/kinde-go/api/users"
 )
 
@@ -131,6 +140,97 @@ func TestAccUserResource_ComplexAttributes(t *testing.T) {
 	})
 }
 
+func TestAccUserResource_ImportByIdentity(t *testing.T) {
+	testID := rand.Int()
+	email := fmt.Sprintf("import.user.tfacc-%d@example.com", testID)
+	username := fmt.Sprintf("import-user-%d", testID)
+
+	checkImportedUser := func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported user, got %d", len(states))
+		}
+		if got := states[0].Attributes["identities.#"]; got != "2" {
+			return fmt.Errorf("expected 2 identities, got %s", got)
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserResourceConfig_ComplexAttributes(email, username, false),
+			},
+			// Import by email
+			{
+				ResourceName:     "kinde_user.complex",
+				ImportState:      true,
+				ImportStateId:    "email:" + email,
+				ImportStateCheck: checkImportedUser,
+			},
+			// Import by username
+			{
+				ResourceName:     "kinde_user.complex",
+				ImportState:      true,
+				ImportStateId:    "username:" + username,
+				ImportStateCheck: checkImportedUser,
+			},
+		},
+	})
+}
+
+func TestUserResource_ImportUserID(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/users":
+			switch {
+			case r.URL.Query().Get("email") == "alice@example.com":
+				_, _ = w.Write([]byte(`{"users":[{"id":"kp_alice"}]}`))
+			case r.URL.Query().Get("username") == "shared":
+				_, _ = w.Write([]byte(`{"users":[{"id":"kp_1"},{"id":"kp_2"}]}`))
+			default:
+				_, _ = w.Write([]byte(`{"users":[]}`))
+			}
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := newTestClient(server)
+	r := &UserResource{client: client.Users}
+	ctx := context.Background()
+
+	tests := []struct {
+		importID string
+		want     string
+		wantErr  bool
+	}{
+		{importID: "kp_plain", want: "kp_plain"},
+		{importID: "email:alice@example.com", want: "kp_alice"},
+		{importID: "email:nobody@example.com", wantErr: true},
+		{importID: "username:shared", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := r.importUserID(ctx, tt.importID)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected error, got user ID %s", tt.importID, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tt.importID, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected user ID %s, got %s", tt.importID, tt.want, got)
+		}
+	}
+}
+
 func testAccUserResourceConfig_ComplexAttributes(email, username string, isSuspended bool) string {
 	return fmt.Sprintf(`
 resource "kinde_user" "complex" {
@@ -467,13 +567,15 @@ func TestUserResource_SortsIdentitiesConsistently(t *testing.T) {
 	}
 }
 
-func TestUserResource_ErrorOnCreateWithIsSuspended(t *testing.T) {
+func TestAccUserResource_CreateWithIsSuspended(t *testing.T) {
+	email := fmt.Sprintf("test-create-suspended-%d-%d@example.com", time.Now().UnixNano(), rand.Intn(1000000))
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: `
+				Config: fmt.Sprintf(`
 resource "kinde_user" "test" {
   first_name   = "Test"
   last_name    = "User"
@@ -481,17 +583,107 @@ resource "kinde_user" "test" {
   identities = [
     {
       type  = "email"
-      value = "test@example.com"
+      value = %q
     }
   ]
 }
-`,
-				ExpectError: regexp.MustCompile("Setting is_suspended=true when creating a user is not supported"),
+`, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("kinde_user.test", "id"),
+					resource.TestCheckResourceAttr("kinde_user.test", "is_suspended", "true"),
+				),
 			},
 		},
 	})
 }
 
+func TestUserResource_CreateSuspendedRollsBack(t *testing.T) {
+	tests := []struct {
+		name        string
+		deleteFails bool
+		wantState   bool
+	}{
+		{name: "deletes the user when the suspension fails"},
+		// A user that could not be deleted is kept in state, so Terraform
+		// marks it as tainted instead of losing track of it
+		{name: "keeps the user when the deletion fails too", deleteFails: true, wantState: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+			server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/api/v1/user":
+					_, _ = w.Write([]byte(`{"id":"kp_alice","created":true}`))
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/user":
+					_, _ = w.Write([]byte(`{"id":"kp_alice","first_name":"Alice","last_name":"Doe"}`))
+				case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/user":
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = w.Write([]byte(`{"errors":[{"code":"INVALID","message":"cannot suspend"}]}`))
+				case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/user" && r.URL.Query().Get("id") == "kp_alice":
+					if tt.deleteFails {
+						w.WriteHeader(http.StatusInternalServerError)
+						_, _ = w.Write([]byte(`{"errors":[{"code":"ERROR","message":"unavailable"}]}`))
+						return
+					}
+					deleted = true
+					_, _ = w.Write([]byte(`{"code":"OK"}`))
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			client := newTestClient(server)
+			r := &UserResource{client: client.Users}
+			ctx := context.Background()
+
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			var identitySchemaResp fwresource.IdentitySchemaResponse
+			r.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+			model := testUserResourceModel("email=alice@example.com")
+			model.ID = types.StringUnknown()
+			model.CreatedOn = types.StringUnknown()
+			model.UpdatedOn = types.StringUnknown()
+			model.IsSuspended = types.BoolValue(true)
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, model); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			resp := fwresource.CreateResponse{
+				State:    tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+				Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema},
+			}
+			r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Error Suspending Created User" {
+				t.Fatalf("expected suspension error, got %v", resp.Diagnostics)
+			}
+			if deleted == tt.deleteFails {
+				t.Errorf("expected deleted %t, got %t", !tt.deleteFails, deleted)
+			}
+
+			if !tt.wantState {
+				if !resp.State.Raw.IsNull() {
+					t.Errorf("expected no state, got %s", resp.State.Raw)
+				}
+				return
+			}
+
+			var state UserResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.ID.ValueString() != "kp_alice" || state.IsSuspended.ValueBool() {
+				t.Errorf("expected active user kp_alice in state, got %+v", state)
+			}
+		})
+	}
+}
+
 func TestAccUserResource_IsSuspendedBehavior(t *testing.T) {
 	// Generate random values for the test
 	email := fmt.Sprintf("test-suspended-%d-%d-%d-%d@example.com", time.Now().UnixNano(), rand.Intn(1000000), rand.Intn(1000000), rand.Intn(1000000))
@@ -563,3 +755,209 @@ resource "kinde_user" "test" {
 }
 `, firstName, lastName, isSuspended, email, phone)
 }
+
+func TestUserResource_UpdateIdentities(t *testing.T) {
+	tests := []struct {
+		name         string
+		identities   []string
+		state        []string
+		plan         []string
+		manageOAuth2 bool
+		wantAdded    []string
+		wantDeleted  []string
+	}{
+		{
+			name: "removes identities dropped from the configuration",
+			identities: []string{
+				`{"id":"identity_alice","type":"email","name":"alice@example.com"}`,
+				`{"id":"identity_old","type":"email","name":"old@example.com"}`,
+				`{"id":"identity_username","type":"username","name":"alice"}`,
+				`{"id":"identity_google","type":"oauth2:google","name":"alice@gmail.com"}`,
+			},
+			state:     []string{"email=alice@example.com", "email=old@example.com", "username=alice"},
+			plan:      []string{"email=alice@example.com", "email=new@example.com"},
+			wantAdded: []string{"new@example.com"},
+			// The OAuth2 identity is not managed and stays on the user
+			wantDeleted: []string{"identity_old", "identity_username"},
+		},
+		{
+			name: "removes only the identity of the dropped type",
+			identities: []string{
+				`{"id":"identity_email","type":"email","name":"alice@example.com"}`,
+				`{"id":"identity_username","type":"username","name":"alice@example.com"}`,
+			},
+			state:       []string{"email=alice@example.com", "username=alice@example.com"},
+			plan:        []string{"email=alice@example.com"},
+			wantDeleted: []string{"identity_username"},
+		},
+		{
+			name: "replaces an identity whose type changed",
+			identities: []string{
+				`{"id":"identity_email","type":"email","name":"alice@example.com"}`,
+				`{"id":"identity_username","type":"username","name":"alice"}`,
+			},
+			state:       []string{"email=alice@example.com", "username=alice"},
+			plan:        []string{"email=alice@example.com", "email=alice"},
+			wantAdded:   []string{"alice"},
+			wantDeleted: []string{"identity_username"},
+		},
+		{
+			name: "enabling manage_oauth2_identities keeps existing OAuth2 identities",
+			identities: []string{
+				`{"id":"identity_alice","type":"email","name":"alice@example.com"}`,
+				`{"id":"identity_google","type":"oauth2:google","name":"alice@gmail.com"}`,
+			},
+			state:        []string{"email=alice@example.com"},
+			plan:         []string{"email=alice@example.com"},
+			manageOAuth2: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identities := slices.Clone(tt.identities)
+			var added, deleted []string
+
+			server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/api/v1/user" && (r.Method == http.MethodGet || r.Method == http.MethodPatch):
+					_, _ = w.Write([]byte(`{"id":"kp_alice","first_name":"Alice","last_name":"Doe"}`))
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/users/kp_alice/identities":
+					_, _ = w.Write([]byte(`{"identities":[` + strings.Join(identities, ",") + `]}`))
+				case r.Method == http.MethodPost && r.URL.Path == "/api/v1/users/kp_alice/identities":
+					var params users.AddIdentityParams
+					_ = json.NewDecoder(r.Body).Decode(&params)
+					added = append(added, params.Value)
+					identities = append(identities, fmt.Sprintf(`{"id":"identity_new","type":%q,"name":%q}`, params.Type, params.Value))
+					_, _ = w.Write([]byte(`{"code":"OK","identity":{"id":"identity_new"}}`))
+				case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v1/identities/"):
+					id := strings.TrimPrefix(r.URL.Path, "/api/v1/identities/")
+					deleted = append(deleted, id)
+					identities = slices.DeleteFunc(identities, func(identity string) bool {
+						return strings.Contains(identity, `"id":"`+id+`"`)
+					})
+					_, _ = w.Write([]byte(`{"code":"OK"}`))
+				default:
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			client := newTestClient(server)
+			r := &UserResource{client: client.Users, identities: client.Identities}
+			ctx := context.Background()
+
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			var identitySchemaResp fwresource.IdentitySchemaResponse
+			r.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+			stateModel := testUserResourceModel(tt.state...)
+			planModel := testUserResourceModel(tt.plan...)
+			if tt.manageOAuth2 {
+				planModel.ManageOAuth2Identities = types.BoolValue(true)
+			}
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			diags := state.Set(ctx, stateModel)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			diags.Append(plan.Set(ctx, planModel)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			resp := fwresource.UpdateResponse{
+				State:    tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw},
+				Identity: &tfsdk.ResourceIdentity{Schema: identitySchemaResp.IdentitySchema},
+			}
+			r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			sort.Strings(deleted)
+			if !slices.Equal(added, tt.wantAdded) {
+				t.Errorf("expected %v to be added, got %v", tt.wantAdded, added)
+			}
+			if !slices.Equal(deleted, tt.wantDeleted) {
+				t.Errorf("expected %v to be removed, got %v", tt.wantDeleted, deleted)
+			}
+
+			// The state matches the plan, otherwise Terraform reports an
+			// inconsistent result after apply
+			var result UserResourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &result)...)
+			if !result.Identities.Equal(planModel.Identities) {
+				t.Errorf("expected identities %s in state, got %s", planModel.Identities, result.Identities)
+			}
+		})
+	}
+}
+
+// testUserResourceModel returns the model of user kp_alice with identities
+// given as type=value.
+func testUserResourceModel(identities ...string) UserResourceModel {
+	identityType := types.ObjectType{AttrTypes: map[string]attr.Type{"type": types.StringType, "value": types.StringType}}
+
+	var elements []attr.Value
+	for _, identity := range identities {
+		t, value, _ := strings.Cut(identity, "=")
+		elements = append(elements, types.ObjectValueMust(identityType.AttrTypes, map[string]attr.Value{
+			"type":  types.StringValue(t),
+			"value": types.StringValue(value),
+		}))
+	}
+
+	return UserResourceModel{
+		ID:         types.StringValue("kp_alice"),
+		FirstName:  types.StringValue("Alice"),
+		LastName:   types.StringValue("Doe"),
+		CreatedOn:  types.StringValue("2024-01-01 00:00:00 +0000 UTC"),
+		UpdatedOn:  types.StringValue("2024-01-01 00:00:00 +0000 UTC"),
+		Identities: types.SetValueMust(identityType, elements),
+	}
+}
+
+func TestUserResource_ValidateConfigIdentityTypes(t *testing.T) {
+	r := &UserResource{}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		identities   []string
+		manageOAuth2 bool
+		wantErr      string
+	}{
+		{identities: []string{"email=alice@example.com", "phone=+15551234567"}},
+		{identities: []string{"email=alice@example.com", "social=alice"}, wantErr: "Unsupported Identity Type"},
+		{identities: []string{"email=alice@example.com", "enterprise=alice"}, wantErr: "Unsupported Identity Type"},
+		{identities: []string{"email=alice@example.com", "oauth2:google=alice@gmail.com"}, wantErr: "Unmanaged OAuth2 Identity"},
+		{identities: []string{"email=alice@example.com", "oauth2:google=alice@gmail.com"}, manageOAuth2: true},
+	}
+
+	for _, tt := range tests {
+		model := testUserResourceModel(tt.identities...)
+		if tt.manageOAuth2 {
+			model.ManageOAuth2Identities = types.BoolValue(true)
+		}
+
+		config := tfsdk.Config{Schema: schemaResp.Schema}
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, model); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		config.Raw = state.Raw
+
+		var resp fwresource.ValidateConfigResponse
+		r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: config}, &resp)
+
+		switch {
+		case tt.wantErr == "" && resp.Diagnostics.HasError():
+			t.Errorf("%v: unexpected error: %v", tt.identities, resp.Diagnostics)
+		case tt.wantErr != "" && (!resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.wantErr):
+			t.Errorf("%v: expected error %q, got %v", tt.identities, tt.wantErr, resp.Diagnostics)
+		}
+	}
+}
@@ -8,19 +8,19 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/nxt-fwd/kinde-go"
 	"github.com/nxt-fwd/kinde-go/api/organizations"
 )
 
 var (
 	_ resource.Resource                = &UserRoleResource{}
 	_ resource.ResourceWithImportState = &UserRoleResource{}
+	_ resource.ResourceWithIdentity    = &UserRoleResource{}
 )
 
 func NewUserRoleResource() resource.Resource {
@@ -28,7 +28,8 @@ func NewUserRoleResource() resource.Resource {
 }
 
 type UserRoleResource struct {
-	client *organizations.Client
+	client  *organizations.Client
+	batcher *organizationUserBatcher
 }
 
 type UserRoleResourceModel struct {
@@ -38,13 +39,29 @@ type UserRoleResourceModel struct {
 	OrganizationCode types.String `tfsdk:"organization_code"`
 }
 
+// UserRoleResourceIdentityModel identifies a role assignment by organization, user
+// and role.
+type UserRoleResourceIdentityModel struct {
+	OrganizationCode types.String `tfsdk:"organization_code"`
+	UserID           types.String `tfsdk:"user_id"`
+	RoleID           types.String `tfsdk:"role_id"`
+}
+
+func userRoleIdentity(model UserRoleResourceModel) UserRoleResourceIdentityModel {
+	return UserRoleResourceIdentityModel{
+		OrganizationCode: model.OrganizationCode,
+		UserID:           model.UserID,
+		RoleID:           model.RoleID,
+	}
+}
+
 func (r *UserRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user_role"
 }
 
 func (r *UserRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Assigns a role to a user within an organization. See [documentation](https://docs.kinde.com/kinde-apis/management/#tag/organizations/post/api/v1/organizations/{org_code}/users/{user_id}/roles) for more details.",
+		MarkdownDescription: "Assigns a role to a user within an organization. Roles assigned in the same apply are batched per organization. If a `kinde_organization_user` for the same user is being created in the same apply, the role is sent with its membership request, otherwise roles of existing members are sent as a single bulk update. See [documentation](https://docs.kinde.com/kinde-apis/management/#tag/organizations/post/api/v1/organizations/{org_code}/users/{user_id}/roles) for more details.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -71,21 +88,41 @@ func (r *UserRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *UserRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"organization_code": identityschema.StringAttribute{
+				Description:       "Code of the organization",
+				RequiredForImport: true,
+			},
+			"user_id": identityschema.StringAttribute{
+				Description:       "ID of the user",
+				RequiredForImport: true,
+			},
+			"role_id": identityschema.StringAttribute{
+				Description:       "ID of the role",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *UserRoleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*kinde.Client)
+	client, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *kinde.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	r.client = client.Organizations
+	r.batcher = client.batcher
 }
 
 func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -96,8 +133,9 @@ func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Check if user has any roles in the organization (this verifies membership)
-	_, err := r.client.GetUserRoles(ctx, plan.OrganizationCode.ValueString(), plan.UserID.ValueString())
+	// Roles are batched with the concurrent membership and role writes of the
+	// organization
+	err := r.batcher.AddUserRole(ctx, plan.OrganizationCode.ValueString(), plan.UserID.ValueString(), plan.RoleID.ValueString())
 	if err != nil {
 		if strings.Contains(strings.ToLower(err.Error()), "user_not_in_organization") {
 			resp.Diagnostics.AddError(
@@ -109,20 +147,6 @@ func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateReques
 			)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error Checking User Organization Membership",
-			fmt.Sprintf("Could not verify if user %s is a member of organization %s: %s",
-				plan.UserID.ValueString(),
-				plan.OrganizationCode.ValueString(),
-				err,
-			),
-		)
-		return
-	}
-
-	// Assign role to user
-	err = r.client.AddUserRole(ctx, plan.OrganizationCode.ValueString(), plan.UserID.ValueString(), plan.RoleID.ValueString())
-	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Assigning Role to User",
 			fmt.Sprintf("Could not assign role %s to user %s in organization %s: %s",
@@ -140,6 +164,7 @@ func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateReques
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, userRoleIdentity(plan))...)
 }
 
 func (r *UserRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -180,6 +205,7 @@ func (r *UserRoleResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, userRoleIdentity(state))...)
 }
 
 func (r *UserRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -214,18 +240,5 @@ func (r *UserRoleResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *UserRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import format: organization_code:user_id:role_id
-	idParts := strings.Split(req.ID, ":")
-	if len(idParts) != 3 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			"Import ID must be in the format: organization_code:user_id:role_id",
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_code"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), idParts[2])...)
+	importCompositeID(ctx, req, resp, "organization_code", "user_id", "role_id")
 }
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nxt-fwd/kinde-go/api/users"
+)
+
+// flattenUserResource sets the attributes of the model from the user and its
+// identities. Names and is_suspended are only set when the model already
+// manages them, and identity types of the model take precedence over the
+// types returned by the API.
+func flattenUserResource(ctx context.Context, user *users.User, identities []users.Identity, model *UserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Convert identities to Terraform state
+	var tfIdentities []struct {
+		Type  string `tfsdk:"type"`
+		Value string `tfsdk:"value"`
+	}
+
+	// If we have existing state identities, use them to preserve the types
+	var stateIdentitiesMap map[string]string
+	if !model.Identities.IsNull() && !model.Identities.IsUnknown() {
+		stateIdentitiesMap = make(map[string]string)
+		var stateIdentities []struct {
+			Type  string `tfsdk:"type"`
+			Value string `tfsdk:"value"`
+		}
+		diags.Append(model.Identities.ElementsAs(ctx, &stateIdentities, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Create a map of value -> type from state
+		for _, identity := range stateIdentities {
+			stateIdentitiesMap[identity.Value] = identity.Type
+		}
+	}
+
+	// Process API identities
+	for _, identity := range identities {
+		// Skip identities that cannot be configured when storing in state
+		if !isManagedIdentity(identity.Type, model.ManageOAuth2Identities) {
+			continue
+		}
+
+		// Use the type from state if available, otherwise use API type
+		identityType := identity.Type
+		if stateIdentitiesMap != nil {
+			if stateType, exists := stateIdentitiesMap[identity.Name]; exists {
+				identityType = stateType
+			}
+		}
+
+		tfIdentities = append(tfIdentities, struct {
+			Type  string `tfsdk:"type"`
+			Value string `tfsdk:"value"`
+		}{
+			Type:  identityType,
+			Value: identity.Name,
+		})
+	}
+
+	// Sort identities consistently by type and then by value
+	sort.Slice(tfIdentities, func(i, j int) bool {
+		if tfIdentities[i].Type == tfIdentities[j].Type {
+			return tfIdentities[i].Value < tfIdentities[j].Value
+		}
+		return tfIdentities[i].Type < tfIdentities[j].Type
+	})
+
+	model.ID = types.StringValue(user.ID)
+
+	// Handle first_name: only set if it was previously set in state
+	if !model.FirstName.IsNull() {
+		model.FirstName = types.StringValue(user.FirstName)
+	}
+
+	// Handle last_name: only set if it was previously set in state
+	if !model.LastName.IsNull() {
+		model.LastName = types.StringValue(user.LastName)
+	}
+
+	// Only set is_suspended in state if it was previously configured
+	if !model.IsSuspended.IsNull() {
+		model.IsSuspended = types.BoolValue(user.IsSuspended)
+	}
+
+	model.CreatedOn = types.StringValue(user.CreatedOn.String())
+	model.UpdatedOn = types.StringValue(user.UpdatedOn.String())
+
+	// Convert identities to set
+	identitiesSet, setDiags := types.SetValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type":  types.StringType,
+			"value": types.StringType,
+		},
+	}, tfIdentities)
+	diags.Append(setDiags...)
+	model.Identities = identitiesSet
+
+	return diags
+}
+
+// isOAuth2Identity reports whether an identity type is one of the oauth2:*
+// types, e.g. oauth2:google, that Kinde creates when a user signs in with a
+// social connection.
+func isOAuth2Identity(identityType string) bool {
+	return strings.HasPrefix(identityType, "oauth2:")
+}
+
+// isConnectionIdentity reports whether an identity type is one of the
+// enterprise and social types, which cannot be configured.
+func isConnectionIdentity(identityType string) bool {
+	switch users.IdentityType(identityType) {
+	case users.IdentityTypeEnterprise, users.IdentityTypeSocial:
+		return true
+	default:
+		return false
+	}
+}
+
+// isManagedIdentity reports whether identities of a type are part of the
+// identities of a kinde_user. OAuth2 identities are only managed when
+// manage_oauth2_identities is set.
+func isManagedIdentity(identityType string, manageOAuth2 types.Bool) bool {
+	if isOAuth2Identity(identityType) {
+		return manageOAuth2.ValueBool()
+	}
+	return !isConnectionIdentity(identityType)
+}
+
+// canAddIdentity reports whether identities of a type can be added to a user
+// through the API. Enterprise, social and OAuth2 identities are only created
+// when the user signs in through the corresponding connection.
+func canAddIdentity(identityType string) bool {
+	switch users.IdentityType(identityType) {
+	case users.IdentityTypeEmail, users.IdentityTypeUsername, users.IdentityTypePhone:
+		return true
+	default:
+		return false
+	}
+}
+
+// unsupportedIdentityTypeError is the diagnostic detail for identities that
+// cannot be added to a user.
+func unsupportedIdentityTypeError(identityType, value string) string {
+	return fmt.Sprintf("Identity %s of type %s cannot be added by Terraform. Identities of type %s, %s and oauth2:* are created when the user signs in through the connection, only %s, %s and %s identities can be added.",
+		value, identityType, users.IdentityTypeEnterprise, users.IdentityTypeSocial, users.IdentityTypeEmail, users.IdentityTypeUsername, users.IdentityTypePhone)
+}
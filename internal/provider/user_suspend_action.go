@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nxt-fwd/kinde-go/api/users"
+)
+
+var (
+	_ action.Action              = &UserSuspendAction{}
+	_ action.ActionWithConfigure = &UserSuspendAction{}
+)
+
+func NewUserSuspendAction() action.Action {
+	return &UserSuspendAction{}
+}
+
+type UserSuspendAction struct {
+	client *users.Client
+}
+
+type UserSuspendActionModel struct {
+	UserID    types.String `tfsdk:"user_id"`
+	Suspended types.Bool   `tfsdk:"suspended"`
+}
+
+func (a *UserSuspendAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_suspend"
+}
+
+func (a *UserSuspendAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Suspends or reactivates a user. Suspended users cannot sign in. A `kinde_user` resource that sets `is_suspended` for the same user will report the change as drift.",
+
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user",
+				Required:            true,
+			},
+			"suspended": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is suspended. Set to `false` to reactivate the user. Defaults to `true`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *UserSuspendAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client.Users
+}
+
+func (a *UserSuspendAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config UserSuspendActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := config.UserID.ValueString()
+	suspended := config.Suspended.IsNull() || config.Suspended.ValueBool()
+
+	tflog.Debug(ctx, "Setting user suspension", map[string]interface{}{
+		"user_id":   userID,
+		"suspended": suspended,
+	})
+
+	_, err := a.client.Update(ctx, userID, users.UpdateParams{IsSuspended: &suspended})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating User",
+			fmt.Sprintf("Could not update suspension of user %s: %s", userID, err),
+		)
+		return
+	}
+
+	if suspended {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Suspended user %s", userID)})
+	} else {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Reactivated user %s", userID)})
+	}
+}
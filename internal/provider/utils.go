@@ -1,13 +1,20 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
 	"sort"
 	"strings"
 )
 
 // Package provider contains the provider implementation.
 
+// importKeyPrefix marks import IDs that refer to a resource by its key instead
+// of its ID.
+const importKeyPrefix = "key:"
+
 // splitID splits a colon-separated ID into its parts and validates the number of parts.
 func splitID(id string, expectedParts int, format string) ([]string, error) {
 	parts := strings.Split(id, ":")
@@ -26,3 +33,39 @@ func sortStringSlice(slice []string) []string {
 	sort.Strings(sorted)
 	return sorted
 }
+
+// stringSliceDifference returns the elements of a that are not in b, preserving
+// the order of a.
+func stringSliceDifference(a, b []string) []string {
+	exclude := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		exclude[s] = struct{}{}
+	}
+
+	var diff []string
+	for _, s := range a {
+		if _, ok := exclude[s]; !ok {
+			diff = append(diff, s)
+		}
+	}
+	return diff
+}
+
+// isNotFoundError reports whether err is a request error carrying a 404 status
+// code. The kinde client keeps its RequestError type internal, so the status
+// code is read from the StatusCode field of each error in the chain.
+func isNotFoundError(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		v := reflect.ValueOf(err)
+		if v.Kind() == reflect.Pointer {
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			continue
+		}
+		if code := v.FieldByName("StatusCode"); code.IsValid() && code.CanInt() {
+			return code.Int() == http.StatusNotFound
+		}
+	}
+	return false
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/nxt-fwd/terraform-provider-kinde/internal/provider"
@@ -22,6 +23,13 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -38,3 +46,23 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// export writes Terraform configuration with import blocks for the objects of
+// an existing Kinde environment, e.g.
+//
+//	terraform-provider-kinde export -out ./kinde
+func export(args []string) error {
+	var opts provider.ExportOptions
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.StringVar(&opts.Domain, "domain", "", "Kinde organisation domain, also set by KINDE_DOMAIN")
+	flags.StringVar(&opts.Audience, "audience", "", "Kinde M2M application audience, also set by KINDE_AUDIENCE")
+	flags.StringVar(&opts.ClientID, "client-id", "", "Kinde M2M application client id, also set by KINDE_CLIENT_ID")
+	flags.StringVar(&opts.ClientSecret, "client-secret", "", "Kinde M2M application client secret, also set by KINDE_CLIENT_SECRET")
+	flags.StringVar(&opts.OutputDir, "out", ".", "directory to write the generated .tf files to, existing files are not overwritten")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	return provider.Export(context.Background(), opts)
+}